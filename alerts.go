@@ -1,6 +1,9 @@
 package paddle
 
-import "time"
+import (
+	"net/url"
+	"time"
+)
 
 // SubscriptionPaymentSucceededAlert is fired when a subscription payment is received successfully.
 // Docs: https://developer.paddle.com/webhook-reference/subscription-alerts/subscription-payment-succeeded
@@ -180,6 +183,343 @@ type SubscriptionPaymentRefundedAlert struct {
 	UserID                  uint64     `schema:"user_id"`
 }
 
+// PaymentSucceededAlert is fired when a one-off purchase payment is received successfully.
+// Docs: https://developer.paddle.com/webhook-reference/one-off-purchase-alerts/payment-succeeded
+type PaymentSucceededAlert struct {
+	AlertName         string    `schema:"alert_name"`
+	AlertID           uint64    `schema:"alert_id"`
+	BalanceCurrency   *string   `schema:"balance_currency"`
+	BalanceEarnings   *string   `schema:"balance_earnings"`
+	BalanceFee        *string   `schema:"balance_fee"`
+	BalanceGross      *string   `schema:"balance_gross"`
+	BalanceTax        *string   `schema:"balance_tax"`
+	CheckoutID        *string   `schema:"checkout_id"`
+	Country           *string   `schema:"country"`
+	Coupon            *string   `schema:"coupon"`
+	Currency          *string   `schema:"currency"`
+	CustomerName      *string   `schema:"customer_name"`
+	Earnings          *string   `schema:"earnings"`
+	Email             *string   `schema:"email"`
+	EventTime         time.Time `schema:"event_time"`
+	Fee               *string   `schema:"fee"`
+	IP                *string   `schema:"ip"`
+	MarketingConsent  bool      `schema:"marketing_consent"`
+	OrderID           *string   `schema:"order_id"`
+	Passthrough       *string   `schema:"passthrough"`
+	PaymentMethod     *string   `schema:"payment_method"`
+	PaymentTax        *string   `schema:"payment_tax"`
+	ProductID         uint64    `schema:"product_id"`
+	ProductName       *string   `schema:"product_name"`
+	Quantity          *string   `schema:"quantity"`
+	ReceiptURL        *string   `schema:"receipt_url"`
+	SaleGross         *string   `schema:"sale_gross"`
+	UsedPriceOverride bool      `schema:"used_price_override"`
+}
+
+// PaymentRefundedAlert is fired when a one-off purchase payment is refunded.
+// Docs: https://developer.paddle.com/webhook-reference/one-off-purchase-alerts/payment-refunded
+type PaymentRefundedAlert struct {
+	AlertName               string     `schema:"alert_name"`
+	AlertID                 uint64     `schema:"alert_id"`
+	Amount                  *string    `schema:"amount"`
+	BalanceCurrency         *string    `schema:"balance_currency"`
+	BalanceEarningsDecrease *string    `schema:"balance_earnings_decrease"`
+	BalanceFeeRefund        *string    `schema:"balance_fee_refund"`
+	BalanceGrossRefund      *string    `schema:"balance_gross_refund"`
+	BalanceTaxRefund        *string    `schema:"balance_tax_refund"`
+	CheckoutID              *string    `schema:"checkout_id"`
+	Currency                *string    `schema:"currency"`
+	EarningsDecrease        *string    `schema:"earnings_decrease"`
+	Email                   *string    `schema:"email"`
+	EventTime               time.Time  `schema:"event_time"`
+	FeeRefund               *string    `schema:"fee_refund"`
+	GrossRefund             *string    `schema:"gross_refund"`
+	MarketingConsent        bool       `schema:"marketing_consent"`
+	OrderID                 *string    `schema:"order_id"`
+	Passthrough             *string    `schema:"passthrough"`
+	Quantity                *string    `schema:"quantity"`
+	RefundReason            *string    `schema:"refund_reason"`
+	RefundType              RefundType `schema:"refund_type"`
+	TaxRefund               *string    `schema:"tax_refund"`
+}
+
+// PaymentDisputeCreatedAlert is fired when a dispute (chargeback) is raised for a card transaction.
+// Docs: https://developer.paddle.com/webhook-reference/risk-dispute-alerts/payment-dispute-created
+type PaymentDisputeCreatedAlert struct {
+	AlertName        string        `schema:"alert_name"`
+	AlertID          uint64        `schema:"alert_id"`
+	Amount           *string       `schema:"amount"`
+	BalanceAmount    *string       `schema:"balance_amount"`
+	BalanceCurrency  *string       `schema:"balance_currency"`
+	BalanceFee       *string       `schema:"balance_fee"`
+	CheckoutID       *string       `schema:"checkout_id"`
+	Currency         *string       `schema:"currency"`
+	Email            *string       `schema:"email"`
+	EventTime        time.Time     `schema:"event_time"`
+	FeeUSD           *string       `schema:"fee_usd"`
+	MarketingConsent bool          `schema:"marketing_consent"`
+	OrderID          *string       `schema:"order_id"`
+	Passthrough      *string       `schema:"passthrough"`
+	Status           DisputeStatus `schema:"status"`
+}
+
+// PaymentDisputeClosedAlert is fired when a dispute (chargeback) is closed.
+// Docs: https://developer.paddle.com/webhook-reference/risk-dispute-alerts/payment-dispute-closed
+type PaymentDisputeClosedAlert struct {
+	AlertName        string        `schema:"alert_name"`
+	AlertID          uint64        `schema:"alert_id"`
+	Amount           *string       `schema:"amount"`
+	BalanceAmount    *string       `schema:"balance_amount"`
+	BalanceCurrency  *string       `schema:"balance_currency"`
+	BalanceFee       *string       `schema:"balance_fee"`
+	CheckoutID       *string       `schema:"checkout_id"`
+	Currency         *string       `schema:"currency"`
+	Email            *string       `schema:"email"`
+	EventTime        time.Time     `schema:"event_time"`
+	FeeUSD           *string       `schema:"fee_usd"`
+	MarketingConsent bool          `schema:"marketing_consent"`
+	OrderID          *string       `schema:"order_id"`
+	Passthrough      *string       `schema:"passthrough"`
+	Status           DisputeStatus `schema:"status"`
+}
+
+// HighRiskTransactionCreatedAlert is fired when a transaction is held for the manual fraud review.
+// Docs: https://developer.paddle.com/webhook-reference/risk-dispute-alerts/high-risk-transaction-created
+type HighRiskTransactionCreatedAlert struct {
+	AlertName            string           `schema:"alert_name"`
+	AlertID              uint64           `schema:"alert_id"`
+	CaseID               uint64           `schema:"case_id"`
+	CheckoutID           *string          `schema:"checkout_id"`
+	CreatedAt            time.Time        `schema:"created_at"`
+	CustomerEmailAddress *string          `schema:"customer_email_address"`
+	CustomerUserID       uint64           `schema:"customer_user_id"`
+	EventTime            time.Time        `schema:"event_time"`
+	MarketingConsent     bool             `schema:"marketing_consent"`
+	Passthrough          *string          `schema:"passthrough"`
+	ProductID            uint64           `schema:"product_id"`
+	RiskScore            *string          `schema:"risk_score"`
+	Status               RiskReviewStatus `schema:"status"`
+}
+
+// HighRiskTransactionUpdatedAlert is fired when the fraud review of a held transaction is completed.
+// Docs: https://developer.paddle.com/webhook-reference/risk-dispute-alerts/high-risk-transaction-updated
+type HighRiskTransactionUpdatedAlert struct {
+	AlertName            string           `schema:"alert_name"`
+	AlertID              uint64           `schema:"alert_id"`
+	CaseID               uint64           `schema:"case_id"`
+	CheckoutID           *string          `schema:"checkout_id"`
+	CreatedAt            time.Time        `schema:"created_at"`
+	CustomerEmailAddress *string          `schema:"customer_email_address"`
+	CustomerUserID       uint64           `schema:"customer_user_id"`
+	EventTime            time.Time        `schema:"event_time"`
+	MarketingConsent     bool             `schema:"marketing_consent"`
+	OrderID              *string          `schema:"order_id"`
+	Passthrough          *string          `schema:"passthrough"`
+	ProductID            uint64           `schema:"product_id"`
+	RiskScore            *string          `schema:"risk_score"`
+	Status               RiskReviewStatus `schema:"status"`
+}
+
+// LockerProcessedAlert is fired when an order is created after a successful payment, and the locker with the purchased product is ready.
+// Docs: https://developer.paddle.com/webhook-reference/one-off-purchase-alerts/order-processing-completed
+type LockerProcessedAlert struct {
+	AlertName        string    `schema:"alert_name"`
+	AlertID          uint64    `schema:"alert_id"`
+	CheckoutID       *string   `schema:"checkout_id"`
+	CheckoutRecovery bool      `schema:"checkout_recovery"`
+	Coupon           *string   `schema:"coupon"`
+	Download         *string   `schema:"download"`
+	Email            *string   `schema:"email"`
+	EventTime        time.Time `schema:"event_time"`
+	Instructions     *string   `schema:"instructions"`
+	Licence          *string   `schema:"licence"`
+	MarketingConsent bool      `schema:"marketing_consent"`
+	OrderID          *string   `schema:"order_id"`
+	ProductID        uint64    `schema:"product_id"`
+	Quantity         *string   `schema:"quantity"`
+	Source           *string   `schema:"source"`
+}
+
+// TransferCreatedAlert is fired when a new payout transfer is created for the vendor.
+// Docs: https://developer.paddle.com/webhook-reference/payout-alerts/transfer-created
+type TransferCreatedAlert struct {
+	AlertName string    `schema:"alert_name"`
+	AlertID   uint64    `schema:"alert_id"`
+	Amount    *string   `schema:"amount"`
+	Currency  *string   `schema:"currency"`
+	EventTime time.Time `schema:"event_time"`
+	PayoutID  uint64    `schema:"payout_id"`
+	Status    *string   `schema:"status"`
+}
+
+// TransferPaidAlert is fired when a payout transfer is paid to the vendor.
+// Docs: https://developer.paddle.com/webhook-reference/payout-alerts/transfer-paid
+type TransferPaidAlert struct {
+	AlertName string    `schema:"alert_name"`
+	AlertID   uint64    `schema:"alert_id"`
+	Amount    *string   `schema:"amount"`
+	Currency  *string   `schema:"currency"`
+	EventTime time.Time `schema:"event_time"`
+	PayoutID  uint64    `schema:"payout_id"`
+	Status    *string   `schema:"status"`
+}
+
+// NewAudienceMemberAlert is fired when a customer opts in to receive marketing communication.
+// Docs: https://developer.paddle.com/webhook-reference/audience-alerts/new-audience-member
+type NewAudienceMemberAlert struct {
+	AlertName        string    `schema:"alert_name"`
+	AlertID          uint64    `schema:"alert_id"`
+	CreatedAt        time.Time `schema:"created_at"`
+	Email            *string   `schema:"email"`
+	EventTime        time.Time `schema:"event_time"`
+	MarketingConsent bool      `schema:"marketing_consent"`
+	// Products is a comma-separated list of the product IDs the customer purchased.
+	Products   *string `schema:"products"`
+	Source     *string `schema:"source"`
+	Subscribed bool    `schema:"subscribed"`
+	UserID     uint64  `schema:"user_id"`
+}
+
+// UpdateAudienceMemberAlert is fired when the email or the marketing consent of an audience member changes.
+// Docs: https://developer.paddle.com/webhook-reference/audience-alerts/update-audience-member
+type UpdateAudienceMemberAlert struct {
+	AlertName           string    `schema:"alert_name"`
+	AlertID             uint64    `schema:"alert_id"`
+	EventTime           time.Time `schema:"event_time"`
+	NewCustomerEmail    *string   `schema:"new_customer_email"`
+	NewMarketingConsent bool      `schema:"new_marketing_consent"`
+	OldCustomerEmail    *string   `schema:"old_customer_email"`
+	OldMarketingConsent bool      `schema:"old_marketing_consent"`
+	// Products is a comma-separated list of the product IDs the customer purchased.
+	Products  *string   `schema:"products"`
+	Source    *string   `schema:"source"`
+	UpdatedAt time.Time `schema:"updated_at"`
+	UserID    uint64    `schema:"user_id"`
+}
+
+// InvoicePaidAlert is fired when an invoice is paid by the customer.
+// Docs: https://developer.paddle.com/webhook-reference/manual-invoicing-alerts/invoice-paid
+type InvoicePaidAlert struct {
+	AlertName           string        `schema:"alert_name"`
+	AlertID             uint64        `schema:"alert_id"`
+	Amount              *string       `schema:"amount"`
+	BalanceCurrency     *string       `schema:"balance_currency"`
+	BalanceEarnings     *string       `schema:"balance_earnings"`
+	BalanceFee          *string       `schema:"balance_fee"`
+	BalanceGross        *string       `schema:"balance_gross"`
+	BalanceTax          *string       `schema:"balance_tax"`
+	Currency            *string       `schema:"currency"`
+	DateCreated         time.Time     `schema:"date_created"`
+	DateReconciled      OptionalTime  `schema:"date_reconciled"`
+	Earnings            *string       `schema:"earnings"`
+	EventTime           time.Time     `schema:"event_time"`
+	Fee                 *string       `schema:"fee"`
+	InvoicedAt          time.Time     `schema:"invoiced_at"`
+	Passthrough         *string       `schema:"passthrough"`
+	PaymentID           uint64        `schema:"payment_id"`
+	PaymentMethod       *string       `schema:"payment_method"`
+	PaymentTax          *string       `schema:"payment_tax"`
+	PurchaseOrderNumber *string       `schema:"purchase_order_number"`
+	SaleGross           *string       `schema:"sale_gross"`
+	Status              InvoiceStatus `schema:"status"`
+	TermDays            int           `schema:"term_days"`
+	InvoiceContract
+	InvoiceCustomer
+	InvoiceProduct
+}
+
+// InvoiceSentAlert is fired when an invoice is sent to the customer.
+// Docs: https://developer.paddle.com/webhook-reference/manual-invoicing-alerts/invoice-sent
+type InvoiceSentAlert struct {
+	AlertName           string        `schema:"alert_name"`
+	AlertID             uint64        `schema:"alert_id"`
+	Amount              *string       `schema:"amount"`
+	BalanceCurrency     *string       `schema:"balance_currency"`
+	Currency            *string       `schema:"currency"`
+	DateCreated         time.Time     `schema:"date_created"`
+	EventTime           time.Time     `schema:"event_time"`
+	InvoicedAt          time.Time     `schema:"invoiced_at"`
+	Passthrough         *string       `schema:"passthrough"`
+	PaymentID           uint64        `schema:"payment_id"`
+	PaymentTax          *string       `schema:"payment_tax"`
+	PurchaseOrderNumber *string       `schema:"purchase_order_number"`
+	SaleGross           *string       `schema:"sale_gross"`
+	Status              InvoiceStatus `schema:"status"`
+	TermDays            int           `schema:"term_days"`
+	InvoiceContract
+	InvoiceCustomer
+	InvoiceProduct
+}
+
+// InvoiceOverdueAlert is fired when an invoice is not paid within the payment terms.
+// Docs: https://developer.paddle.com/webhook-reference/manual-invoicing-alerts/invoice-overdue
+type InvoiceOverdueAlert struct {
+	AlertName           string        `schema:"alert_name"`
+	AlertID             uint64        `schema:"alert_id"`
+	Amount              *string       `schema:"amount"`
+	BalanceCurrency     *string       `schema:"balance_currency"`
+	Currency            *string       `schema:"currency"`
+	DateCreated         time.Time     `schema:"date_created"`
+	EventTime           time.Time     `schema:"event_time"`
+	InvoicedAt          time.Time     `schema:"invoiced_at"`
+	Passthrough         *string       `schema:"passthrough"`
+	PaymentID           uint64        `schema:"payment_id"`
+	PaymentTax          *string       `schema:"payment_tax"`
+	PurchaseOrderNumber *string       `schema:"purchase_order_number"`
+	SaleGross           *string       `schema:"sale_gross"`
+	Status              InvoiceStatus `schema:"status"`
+	TermDays            int           `schema:"term_days"`
+	InvoiceContract
+	InvoiceCustomer
+	InvoiceProduct
+}
+
+// InvoiceProduct represents the invoiced product, it is embedded into the invoice alerts
+// since the alert fields are flat.
+type InvoiceProduct struct {
+	ProductID                    uint64  `schema:"product_id"`
+	ProductName                  *string `schema:"product_name"`
+	ProductAdditionalInformation *string `schema:"product_additional_information"`
+}
+
+// InvoiceCustomer represents the invoiced customer.
+type InvoiceCustomer struct {
+	CustomerID            uint64  `schema:"customer_id"`
+	CustomerName          *string `schema:"customer_name"`
+	Email                 *string `schema:"email"`
+	CustomerVATNumber     *string `schema:"customer_vat_number"`
+	CustomerCompanyNumber *string `schema:"customer_company_number"`
+	InvoiceAddress
+}
+
+// InvoiceAddress represents the billing address of the invoiced customer.
+type InvoiceAddress struct {
+	Address *string `schema:"customer_address"`
+	City    *string `schema:"customer_city"`
+	State   *string `schema:"customer_state"`
+	Zipcode *string `schema:"customer_zipcode"`
+	Country *string `schema:"country"`
+}
+
+// InvoiceContract represents the contract the invoice is issued for.
+type InvoiceContract struct {
+	ContractID        uint64       `schema:"contract_id"`
+	ContractStartDate OptionalTime `schema:"contract_start_date"`
+	ContractEndDate   OptionalTime `schema:"contract_end_date"`
+}
+
+// UnknownAlert represents a verified alert with "alert_name" that is not supported by the library yet.
+// It is returned only by the webhooks created with WithLenientAlerts.
+type UnknownAlert struct {
+	AlertName string
+	// AlertID is zero if "alert_id" is missing or is not a number.
+	AlertID uint64
+	// EventTime is not set if "event_time" is missing or has an unfamiliar format.
+	EventTime OptionalTime
+	// Values contains all the verified alert fields, including the signature.
+	Values url.Values
+}
+
 // OptionalTime represents the Time value that can be zero
 // and forces you to process it differently.
 type OptionalTime struct {
@@ -0,0 +1,320 @@
+package paddle
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	productionBillingBaseURL = "https://api.paddle.com/"
+	sandboxBillingBaseURL    = "https://sandbox-api.paddle.com/"
+)
+
+// BillingClient is a Paddle Billing API client. Unlike the Classic vendor API, Paddle Billing
+// uses JSON bodies and API keys, but the client shares the transport, retries, rate limiting
+// and errors with the Classic Client.
+type BillingClient struct {
+	// Customers represents an API for working with customers.
+	Customers *BillingCustomers
+	// Subscriptions represents an API for working with subscriptions.
+	Subscriptions *BillingSubscriptions
+	// Transactions represents an API for working with transactions.
+	Transactions *BillingTransactions
+	// Prices represents an API for working with prices.
+	Prices *BillingPrices
+}
+
+// NewBillingClient creates a new Paddle Billing client authenticated with the API key.
+// The same options as for NewClient are supported, WithBaseURL overrides the Billing API base URL,
+// and WithCheckoutBaseURL is rejected since the Billing API has no separate checkout host.
+func NewBillingClient(apiKey string, options ...Option) (*BillingClient, error) {
+	if apiKey == "" {
+		return nil, errors.New("API key can't be empty")
+	}
+
+	settings, err := newClientOptions(options)
+	if err != nil {
+		return nil, err
+	}
+	if settings.checkoutBaseURL != "" {
+		return nil, errors.New("checkout base URL is not supported by the Billing API")
+	}
+
+	baseURL, err := parseBaseURL(settings.baseURL, settings.environment, productionBillingBaseURL, sandboxBillingBaseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	billing := &api{
+		apiKey:      apiKey,
+		baseURL:     baseURL,
+		httpClient:  settings.httpClient,
+		userAgent:   settings.userAgent,
+		retryPolicy: settings.retryPolicy,
+		rateLimiter: settings.rateLimiter,
+	}
+
+	return &BillingClient{
+		Customers:     (*BillingCustomers)(billing.copy()),
+		Subscriptions: (*BillingSubscriptions)(billing.copy()),
+		Transactions:  (*BillingTransactions)(billing.copy()),
+		Prices:        (*BillingPrices)(billing.copy()),
+	}, nil
+}
+
+// newBillingRequest prepares a new Paddle Billing HTTP request with the query parameters and the JSON body.
+func newBillingRequest(ctx context.Context, method string, api *api, path string, query urlValuesEncoder, body interface{}) (*http.Request, error) {
+	u, err := prepareURL(api.baseURL, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare a request URL: %w", err)
+	}
+
+	if query != nil {
+		values, err := query.encodeURLValues()
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode URL values: %w", err)
+		}
+		u.RawQuery = values.Encode()
+	}
+
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, method, u.String(), reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to instantiate new request: %w", err)
+	}
+
+	request.Header.Set("Authorization", "Bearer "+api.apiKey)
+	request.Header.Set("Accept", "application/json")
+	if reader != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+
+	return request, nil
+}
+
+// BillingMeta represents the metadata of a Paddle Billing response.
+type BillingMeta struct {
+	RequestID  string             `json:"request_id,omitempty"`
+	Pagination *BillingPagination `json:"pagination,omitempty"`
+}
+
+// BillingPagination represents the cursor pagination of a Paddle Billing list response.
+type BillingPagination struct {
+	PerPage        int    `json:"per_page,omitempty"`
+	Next           string `json:"next,omitempty"`
+	HasMore        bool   `json:"has_more,omitempty"`
+	EstimatedTotal int    `json:"estimated_total,omitempty"`
+}
+
+// after returns the cursor of the next page.
+func (pagination *BillingPagination) after() string {
+	if pagination == nil || !pagination.HasMore || pagination.Next == "" {
+		return ""
+	}
+
+	next, err := url.Parse(pagination.Next)
+	if err != nil {
+		return ""
+	}
+
+	return next.Query().Get("after")
+}
+
+// billingResponse represents a deserialized response from the Paddle Billing API.
+type billingResponse[T any] struct {
+	Data  T             `json:"data"`
+	Meta  BillingMeta   `json:"meta"`
+	Error *BillingError `json:"error"`
+}
+
+// decode decodes the Paddle Billing response, errors are returned as BillingError.
+func (r *billingResponse[T]) decode(httpResponse *http.Response, data []byte) error {
+	*r = billingResponse[T]{}
+	successful := httpResponse.StatusCode >= 200 && httpResponse.StatusCode < 300
+
+	if successful && len(bytes.TrimSpace(data)) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(data, r); err != nil {
+		if !successful {
+			return newHTTPError(httpResponse.StatusCode, data, nil)
+		}
+
+		return newHTTPError(httpResponse.StatusCode, data, fmt.Errorf("failed to unmarshal JSON: %w", err))
+	}
+
+	if r.Error != nil {
+		r.Error.StatusCode = httpResponse.StatusCode
+		r.Error.RequestID = r.Meta.RequestID
+		return r.Error
+	}
+
+	if !successful {
+		return newHTTPError(httpResponse.StatusCode, data, nil)
+	}
+
+	return nil
+}
+
+// BillingError represents a Paddle Billing API error.
+type BillingError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode       int                  `json:"-"`
+	RequestID        string               `json:"-"`
+	Type             string               `json:"type"`
+	Code             string               `json:"code"`
+	Detail           string               `json:"detail"`
+	DocumentationURL string               `json:"documentation_url"`
+	Errors           []*BillingFieldError `json:"errors,omitempty"`
+}
+
+// BillingFieldError represents a validation error of a request field.
+type BillingFieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// Error formats the error as a string.
+func (e *BillingError) Error() string {
+	message := fmt.Sprintf("Paddle Billing API error: status=%d, code=%s, detail=%s", e.StatusCode, e.Code, e.Detail)
+	if len(e.Errors) > 0 {
+		fields := make([]string, len(e.Errors))
+		for i, fieldError := range e.Errors {
+			fields[i] = fieldError.Field + ": " + fieldError.Message
+		}
+		message += ", errors=" + strings.Join(fields, "; ")
+	}
+
+	return message
+}
+
+// Is reports whether the Billing API error belongs to the target error category, e.g. errors.Is(err, ErrNotFound).
+func (e *BillingError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound || e.Code == "not_found"
+	case ErrAuth:
+		return e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests || e.Code == "too_many_requests"
+	case ErrValidation:
+		return e.StatusCode == http.StatusBadRequest || e.StatusCode == http.StatusUnprocessableEntity || (e.Type == "request_error" && len(e.Errors) > 0)
+	}
+
+	return false
+}
+
+// BillingListOptions represents the cursor pagination options of the Billing list requests.
+type BillingListOptions struct {
+	// After is the ID of the last entity of the previous page.
+	After   string
+	PerPage int
+	OrderBy string
+}
+
+// encode encodes pagination options as URL parameters.
+func (options *BillingListOptions) encode(values url.Values) error {
+	if options.PerPage < 0 {
+		return errors.New("\"per_page\" can't be negative")
+	} else if options.PerPage > 0 {
+		values.Set("per_page", strconv.Itoa(options.PerPage))
+	}
+	setString(values, "after", options.After)
+	setString(values, "order_by", options.OrderBy)
+
+	return nil
+}
+
+// BillingMoney represents an amount in the lowest denomination of the currency, e.g. cents.
+type BillingMoney struct {
+	Amount       string `json:"amount"`
+	CurrencyCode string `json:"currency_code"`
+}
+
+// BillingDuration represents a billing cycle or a trial period.
+type BillingDuration struct {
+	Interval  string `json:"interval"`
+	Frequency int    `json:"frequency"`
+}
+
+// setList sets the URL parameter as a comma-separated list if it is not empty.
+func setList(values url.Values, key string, list []string) {
+	if len(list) > 0 {
+		values.Set(key, strings.Join(list, ","))
+	}
+}
+
+// billingList fetches a page of entities of the list endpoint.
+func billingList[T any](ctx context.Context, api *api, path string, options urlValuesEncoder) ([]T, *BillingMeta, *http.Response, error) {
+	request, err := newBillingRequest(ctx, http.MethodGet, api, path, options, nil)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to create new request: %w", err)
+	}
+
+	response := new(billingResponse[[]T])
+	httpResponse, err := doRequest(api, request, response)
+	if err != nil {
+		return nil, nil, httpResponse, err
+	}
+
+	return response.Data, &response.Meta, httpResponse, nil
+}
+
+// billingIter returns an iterator that follows the pagination cursors of the list endpoint.
+func billingIter[T any](ctx context.Context, list func(ctx context.Context, after string) ([]T, *BillingMeta, error), after string, iteratorOptions []IteratorOption) *Iterator[T] {
+	done := false
+
+	return newIterator(ctx, 1, func(ctx context.Context, page int) ([]T, error) {
+		if done {
+			return nil, nil
+		}
+
+		items, meta, err := list(ctx, after)
+		if err != nil {
+			return nil, err
+		}
+
+		after = meta.Pagination.after()
+		done = after == ""
+
+		return items, nil
+	}, iteratorOptions)
+}
+
+// billingDo executes the request of a single entity endpoint.
+func billingDo[T any](ctx context.Context, api *api, method string, path string, body interface{}, idempotent bool) (*T, *http.Response, error) {
+	request, err := newBillingRequest(ctx, method, api, path, nil, body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create new request: %w", err)
+	}
+
+	response := new(billingResponse[*T])
+	var httpResponse *http.Response
+	if idempotent {
+		httpResponse, err = doRequest(api, request, response)
+	} else {
+		httpResponse, err = doNonIdempotentRequest(api, request, response)
+	}
+	if err != nil {
+		return nil, httpResponse, err
+	}
+
+	return response.Data, httpResponse, nil
+}
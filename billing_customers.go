@@ -0,0 +1,141 @@
+package paddle
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// BillingCustomers is an API to work with the Paddle Billing customers.
+type BillingCustomers api
+
+// BillingCustomer represents a Paddle Billing customer.
+type BillingCustomer struct {
+	ID               string                 `json:"id"`
+	Name             *string                `json:"name"`
+	Email            string                 `json:"email"`
+	MarketingConsent bool                   `json:"marketing_consent"`
+	Status           string                 `json:"status"`
+	CustomData       map[string]interface{} `json:"custom_data"`
+	Locale           string                 `json:"locale"`
+	CreatedAt        time.Time              `json:"created_at"`
+	UpdatedAt        time.Time              `json:"updated_at"`
+}
+
+// ListBillingCustomersOptions represents the filters of the customers list.
+type ListBillingCustomersOptions struct {
+	BillingListOptions
+	IDs    []string
+	Emails []string
+	// Status is one of "active" or "archived".
+	Status []string
+	Search string
+}
+
+// encodeURLValues encodes options as URL parameters.
+func (options *ListBillingCustomersOptions) encodeURLValues() (url.Values, error) {
+	values := make(url.Values)
+	if err := options.BillingListOptions.encode(values); err != nil {
+		return nil, err
+	}
+	setList(values, "id", options.IDs)
+	setList(values, "email", options.Emails)
+	setList(values, "status", options.Status)
+	setString(values, "search", options.Search)
+
+	return values, nil
+}
+
+// List lists customers, the pagination cursor is returned in the metadata.
+//
+// Paddle docs: https://developer.paddle.com/api-reference/customers/list-customers
+func (customers *BillingCustomers) List(ctx context.Context, options *ListBillingCustomersOptions) ([]*BillingCustomer, *BillingMeta, *http.Response, error) {
+	path := "customers"
+
+	if options == nil {
+		options = new(ListBillingCustomersOptions)
+	}
+
+	return billingList[*BillingCustomer](ctx, (*api)(customers), path, options)
+}
+
+// Iter returns an iterator over all customers matching the options, starting from options.After.
+func (customers *BillingCustomers) Iter(ctx context.Context, options *ListBillingCustomersOptions, iteratorOptions ...IteratorOption) *Iterator[*BillingCustomer] {
+	var pageOptions ListBillingCustomersOptions
+	if options != nil {
+		pageOptions = *options
+	}
+
+	return billingIter(ctx, func(ctx context.Context, after string) ([]*BillingCustomer, *BillingMeta, error) {
+		pageOptions := pageOptions
+		pageOptions.After = after
+		result, meta, _, err := customers.List(ctx, &pageOptions)
+
+		return result, meta, err
+	}, pageOptions.After, iteratorOptions)
+}
+
+// Get returns the customer by ID.
+//
+// Paddle docs: https://developer.paddle.com/api-reference/customers/get-customer
+func (customers *BillingCustomers) Get(ctx context.Context, customerID string) (*BillingCustomer, *http.Response, error) {
+	if customerID == "" {
+		return nil, nil, errors.New("customer ID is required")
+	}
+
+	path := "customers/" + url.PathEscape(customerID)
+
+	return billingDo[BillingCustomer](ctx, (*api)(customers), http.MethodGet, path, nil, true)
+}
+
+// CreateBillingCustomerOptions represents the customer to create.
+type CreateBillingCustomerOptions struct {
+	Email      string                 `json:"email"`
+	Name       string                 `json:"name,omitempty"`
+	CustomData map[string]interface{} `json:"custom_data,omitempty"`
+	Locale     string                 `json:"locale,omitempty"`
+}
+
+// Create creates a new customer.
+//
+// Paddle docs: https://developer.paddle.com/api-reference/customers/create-customer
+func (customers *BillingCustomers) Create(ctx context.Context, options *CreateBillingCustomerOptions) (*BillingCustomer, *http.Response, error) {
+	path := "customers"
+
+	if options == nil {
+		options = new(CreateBillingCustomerOptions)
+	}
+	if options.Email == "" {
+		return nil, nil, errors.New("\"email\" is required")
+	}
+
+	return billingDo[BillingCustomer](ctx, (*api)(customers), http.MethodPost, path, options, false)
+}
+
+// UpdateBillingCustomerOptions represents the customer fields to update, nil fields are left untouched.
+type UpdateBillingCustomerOptions struct {
+	Name       *string                `json:"name,omitempty"`
+	Email      *string                `json:"email,omitempty"`
+	Status     *string                `json:"status,omitempty"`
+	CustomData map[string]interface{} `json:"custom_data,omitempty"`
+	Locale     *string                `json:"locale,omitempty"`
+}
+
+// Update updates the customer.
+//
+// Paddle docs: https://developer.paddle.com/api-reference/customers/update-customer
+func (customers *BillingCustomers) Update(ctx context.Context, customerID string, options *UpdateBillingCustomerOptions) (*BillingCustomer, *http.Response, error) {
+	if customerID == "" {
+		return nil, nil, errors.New("customer ID is required")
+	}
+
+	path := "customers/" + url.PathEscape(customerID)
+
+	if options == nil {
+		options = new(UpdateBillingCustomerOptions)
+	}
+
+	return billingDo[BillingCustomer](ctx, (*api)(customers), http.MethodPatch, path, options, true)
+}
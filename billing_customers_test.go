@@ -0,0 +1,193 @@
+package paddle
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestBillingCustomersList(t *testing.T) {
+	var query url.Values
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		equals(t, http.MethodGet, req.Method)
+		equals(t, "/customers", req.URL.Path)
+		query = req.URL.Query()
+
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBuffer([]byte(billingCustomersListJSON))),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	u, _ := url.Parse(sandboxBillingBaseURL)
+	customers := BillingCustomers{httpClient: httpClient, baseURL: u, apiKey: "pdl_sdbx_apikey"}
+
+	result, meta, _, err := customers.List(context.Background(), &ListBillingCustomersOptions{
+		BillingListOptions: BillingListOptions{PerPage: 1},
+		Status:             []string{"active", "archived"},
+		Search:             "sam",
+	})
+	ok(t, err)
+
+	equals(t, url.Values{"per_page": {"1"}, "status": {"active,archived"}, "search": {"sam"}}, query)
+	equals(t, 1, len(result))
+	equals(t, "ctm_01h8441jn5pcwrfhwh78jqt8hk", result[0].ID)
+	equals(t, "sam@example.com", result[0].Email)
+	equals(t, "Sam Miller", *result[0].Name)
+	equals(t, time.Date(2023, 8, 16, 14, 38, 43, 0, time.UTC), result[0].CreatedAt.UTC())
+	equals(t, &BillingPagination{PerPage: 1, Next: "https://sandbox-api.paddle.com/customers?after=ctm_01h8441jn5pcwrfhwh78jqt8hk&per_page=1", HasMore: true, EstimatedTotal: 2}, meta.Pagination)
+	equals(t, "ctm_01h8441jn5pcwrfhwh78jqt8hk", meta.Pagination.after())
+}
+
+func TestBillingCustomersIter(t *testing.T) {
+	var afters []string
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		after := req.URL.Query().Get("after")
+		afters = append(afters, after)
+
+		body := billingCustomersListJSON
+		if after != "" {
+			body = billingCustomersLastPageJSON
+		}
+
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBuffer([]byte(body))),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	u, _ := url.Parse(sandboxBillingBaseURL)
+	customers := BillingCustomers{httpClient: httpClient, baseURL: u, apiKey: "pdl_sdbx_apikey"}
+
+	result, err := collect(customers.Iter(context.Background(), &ListBillingCustomersOptions{BillingListOptions: BillingListOptions{PerPage: 1}}))
+	ok(t, err)
+
+	equals(t, []string{"", "ctm_01h8441jn5pcwrfhwh78jqt8hk"}, afters)
+	equals(t, 2, len(result))
+	equals(t, "ctm_01h84cjfwmdph1k8kgsyjt8zbz", result[1].ID)
+}
+
+func TestBillingCustomersCreate(t *testing.T) {
+	var body map[string]interface{}
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		equals(t, http.MethodPost, req.Method)
+		equals(t, "/customers", req.URL.Path)
+		equals(t, "application/json", req.Header.Get("Content-Type"))
+		ok(t, json.NewDecoder(req.Body).Decode(&body))
+
+		return &http.Response{
+			StatusCode: 201,
+			Body:       ioutil.NopCloser(bytes.NewBuffer([]byte(billingCustomerJSON))),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	u, _ := url.Parse(sandboxBillingBaseURL)
+	customers := BillingCustomers{httpClient: httpClient, baseURL: u, apiKey: "pdl_sdbx_apikey"}
+
+	_, _, err := customers.Create(context.Background(), &CreateBillingCustomerOptions{})
+	errorred(t, err, "\"email\" is required")
+
+	result, _, err := customers.Create(context.Background(), &CreateBillingCustomerOptions{Email: "sam@example.com", Name: "Sam Miller"})
+	ok(t, err)
+
+	equals(t, map[string]interface{}{"email": "sam@example.com", "name": "Sam Miller"}, body)
+	equals(t, "ctm_01h8441jn5pcwrfhwh78jqt8hk", result.ID)
+}
+
+func TestBillingCustomersUpdate(t *testing.T) {
+	var body map[string]interface{}
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		equals(t, http.MethodPatch, req.Method)
+		equals(t, "/customers/ctm_01h8441jn5pcwrfhwh78jqt8hk", req.URL.Path)
+		ok(t, json.NewDecoder(req.Body).Decode(&body))
+
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBuffer([]byte(billingCustomerJSON))),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	u, _ := url.Parse(sandboxBillingBaseURL)
+	customers := BillingCustomers{httpClient: httpClient, baseURL: u, apiKey: "pdl_sdbx_apikey"}
+
+	_, _, err := customers.Update(context.Background(), "ctm_01h8441jn5pcwrfhwh78jqt8hk", &UpdateBillingCustomerOptions{Status: String("archived")})
+	ok(t, err)
+
+	equals(t, map[string]interface{}{"status": "archived"}, body)
+}
+
+const billingCustomerJSON = `{
+  "data": {
+    "id": "ctm_01h8441jn5pcwrfhwh78jqt8hk",
+    "name": "Sam Miller",
+    "email": "sam@example.com",
+    "marketing_consent": false,
+    "status": "active",
+    "custom_data": null,
+    "locale": "en",
+    "created_at": "2023-08-16T14:38:43.477Z",
+    "updated_at": "2023-08-16T14:38:43.477Z"
+  },
+  "meta": {
+    "request_id": "bf5b1b5d-7c25-4e6f-a1b4-1f5d5b2b0f7e"
+  }
+}`
+
+const billingCustomersListJSON = `{
+  "data": [
+    {
+      "id": "ctm_01h8441jn5pcwrfhwh78jqt8hk",
+      "name": "Sam Miller",
+      "email": "sam@example.com",
+      "marketing_consent": false,
+      "status": "active",
+      "custom_data": null,
+      "locale": "en",
+      "created_at": "2023-08-16T14:38:43Z",
+      "updated_at": "2023-08-16T14:38:43Z"
+    }
+  ],
+  "meta": {
+    "request_id": "3a9a4e8a-2e5c-4b8a-9d0b-7b6f9e0c1d2a",
+    "pagination": {
+      "per_page": 1,
+      "next": "https://sandbox-api.paddle.com/customers?after=ctm_01h8441jn5pcwrfhwh78jqt8hk&per_page=1",
+      "has_more": true,
+      "estimated_total": 2
+    }
+  }
+}`
+
+const billingCustomersLastPageJSON = `{
+  "data": [
+    {
+      "id": "ctm_01h84cjfwmdph1k8kgsyjt8zbz",
+      "name": null,
+      "email": "jo@example.com",
+      "marketing_consent": true,
+      "status": "active",
+      "custom_data": null,
+      "locale": "en",
+      "created_at": "2023-08-16T17:02:11Z",
+      "updated_at": "2023-08-16T17:02:11Z"
+    }
+  ],
+  "meta": {
+    "request_id": "7c1e8a6b-54f4-4d1a-b3d9-2c6e9a8f4b1d",
+    "pagination": {
+      "per_page": 1,
+      "next": "https://sandbox-api.paddle.com/customers?after=ctm_01h84cjfwmdph1k8kgsyjt8zbz&per_page=1",
+      "has_more": false,
+      "estimated_total": 2
+    }
+  }
+}`
@@ -0,0 +1,141 @@
+package paddle
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// BillingPrices is an API to work with the Paddle Billing prices.
+type BillingPrices api
+
+// BillingPrice represents a Paddle Billing price of a product.
+type BillingPrice struct {
+	ID           string                 `json:"id"`
+	ProductID    string                 `json:"product_id"`
+	Description  string                 `json:"description"`
+	Name         *string                `json:"name"`
+	TaxMode      string                 `json:"tax_mode"`
+	BillingCycle *BillingDuration       `json:"billing_cycle"`
+	TrialPeriod  *BillingDuration       `json:"trial_period"`
+	UnitPrice    BillingMoney           `json:"unit_price"`
+	Quantity     *BillingQuantity       `json:"quantity,omitempty"`
+	Status       string                 `json:"status"`
+	CustomData   map[string]interface{} `json:"custom_data"`
+	CreatedAt    time.Time              `json:"created_at"`
+	UpdatedAt    time.Time              `json:"updated_at"`
+}
+
+// BillingQuantity represents the allowed quantity range of a price.
+type BillingQuantity struct {
+	Minimum int `json:"minimum"`
+	Maximum int `json:"maximum"`
+}
+
+// ListBillingPricesOptions represents the filters of the prices list.
+type ListBillingPricesOptions struct {
+	BillingListOptions
+	IDs        []string
+	ProductIDs []string
+	// Status is one of "active" or "archived".
+	Status    []string
+	Recurring *bool
+}
+
+// encodeURLValues encodes options as URL parameters.
+func (options *ListBillingPricesOptions) encodeURLValues() (url.Values, error) {
+	values := make(url.Values)
+	if err := options.BillingListOptions.encode(values); err != nil {
+		return nil, err
+	}
+	setList(values, "id", options.IDs)
+	setList(values, "product_id", options.ProductIDs)
+	setList(values, "status", options.Status)
+	setBool(values, "recurring", options.Recurring)
+
+	return values, nil
+}
+
+// List lists prices, the pagination cursor is returned in the metadata.
+//
+// Paddle docs: https://developer.paddle.com/api-reference/prices/list-prices
+func (prices *BillingPrices) List(ctx context.Context, options *ListBillingPricesOptions) ([]*BillingPrice, *BillingMeta, *http.Response, error) {
+	path := "prices"
+
+	if options == nil {
+		options = new(ListBillingPricesOptions)
+	}
+
+	return billingList[*BillingPrice](ctx, (*api)(prices), path, options)
+}
+
+// Iter returns an iterator over all prices matching the options, starting from options.After.
+func (prices *BillingPrices) Iter(ctx context.Context, options *ListBillingPricesOptions, iteratorOptions ...IteratorOption) *Iterator[*BillingPrice] {
+	var pageOptions ListBillingPricesOptions
+	if options != nil {
+		pageOptions = *options
+	}
+
+	return billingIter(ctx, func(ctx context.Context, after string) ([]*BillingPrice, *BillingMeta, error) {
+		pageOptions := pageOptions
+		pageOptions.After = after
+		result, meta, _, err := prices.List(ctx, &pageOptions)
+
+		return result, meta, err
+	}, pageOptions.After, iteratorOptions)
+}
+
+// Get returns the price by ID.
+//
+// Paddle docs: https://developer.paddle.com/api-reference/prices/get-price
+func (prices *BillingPrices) Get(ctx context.Context, priceID string) (*BillingPrice, *http.Response, error) {
+	if priceID == "" {
+		return nil, nil, errors.New("price ID is required")
+	}
+
+	path := "prices/" + url.PathEscape(priceID)
+
+	return billingDo[BillingPrice](ctx, (*api)(prices), http.MethodGet, path, nil, true)
+}
+
+// CreateBillingPriceOptions represents the price to create.
+type CreateBillingPriceOptions struct {
+	ProductID   string `json:"product_id"`
+	Description string `json:"description"`
+	Name        string `json:"name,omitempty"`
+	// UnitPrice amount is in the lowest denomination of the currency, e.g. "1000" for 10.00 USD.
+	UnitPrice BillingMoney `json:"unit_price"`
+	// BillingCycle is nil for one-time prices.
+	BillingCycle *BillingDuration       `json:"billing_cycle,omitempty"`
+	TrialPeriod  *BillingDuration       `json:"trial_period,omitempty"`
+	TaxMode      string                 `json:"tax_mode,omitempty"`
+	Quantity     *BillingQuantity       `json:"quantity,omitempty"`
+	CustomData   map[string]interface{} `json:"custom_data,omitempty"`
+}
+
+// Create creates a new price for the product.
+//
+// Paddle docs: https://developer.paddle.com/api-reference/prices/create-price
+func (prices *BillingPrices) Create(ctx context.Context, options *CreateBillingPriceOptions) (*BillingPrice, *http.Response, error) {
+	path := "prices"
+
+	if options == nil {
+		options = new(CreateBillingPriceOptions)
+	}
+	if options.ProductID == "" {
+		return nil, nil, errors.New("\"product_id\" is required")
+	}
+	if options.Description == "" {
+		return nil, nil, errors.New("\"description\" is required")
+	}
+	if options.UnitPrice.CurrencyCode == "" {
+		return nil, nil, errors.New("\"unit_price.currency_code\" is required")
+	}
+	if options.UnitPrice.Amount == "" {
+		return nil, nil, errors.New("\"unit_price.amount\" is required")
+	}
+
+	return billingDo[BillingPrice](ctx, (*api)(prices), http.MethodPost, path, options, false)
+}
@@ -0,0 +1,104 @@
+package paddle
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestBillingPricesCreate(t *testing.T) {
+	var body map[string]interface{}
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		equals(t, http.MethodPost, req.Method)
+		equals(t, "/prices", req.URL.Path)
+		ok(t, json.NewDecoder(req.Body).Decode(&body))
+
+		return &http.Response{
+			StatusCode: 201,
+			Body:       ioutil.NopCloser(bytes.NewBuffer([]byte(billingPriceJSON))),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	u, _ := url.Parse(sandboxBillingBaseURL)
+	prices := BillingPrices{httpClient: httpClient, baseURL: u, apiKey: "pdl_sdbx_apikey"}
+
+	_, _, err := prices.Create(context.Background(), &CreateBillingPriceOptions{ProductID: "pro_01gsz4t5hdjse780zja8vvr7jg"})
+	errorred(t, err, "\"description\" is required")
+
+	_, _, err = prices.Create(context.Background(), &CreateBillingPriceOptions{ProductID: "pro_01gsz4t5hdjse780zja8vvr7jg", Description: "Monthly"})
+	errorred(t, err, "\"unit_price.currency_code\" is required")
+
+	result, _, err := prices.Create(context.Background(), &CreateBillingPriceOptions{
+		ProductID:    "pro_01gsz4t5hdjse780zja8vvr7jg",
+		Description:  "Monthly",
+		UnitPrice:    BillingMoney{Amount: "3000", CurrencyCode: "USD"},
+		BillingCycle: &BillingDuration{Interval: "month", Frequency: 1},
+	})
+	ok(t, err)
+
+	equals(t, map[string]interface{}{
+		"product_id":    "pro_01gsz4t5hdjse780zja8vvr7jg",
+		"description":   "Monthly",
+		"unit_price":    map[string]interface{}{"amount": "3000", "currency_code": "USD"},
+		"billing_cycle": map[string]interface{}{"interval": "month", "frequency": float64(1)},
+	}, body)
+	equals(t, "pri_01gsz8x8sawmvhz1pv30nge1ke", result.ID)
+	equals(t, &BillingQuantity{Minimum: 1, Maximum: 100}, result.Quantity)
+}
+
+func TestBillingPricesListRecurring(t *testing.T) {
+	var query url.Values
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		query = req.URL.Query()
+
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBuffer([]byte(`{"data":[],"meta":{"request_id":"1"}}`))),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	u, _ := url.Parse(sandboxBillingBaseURL)
+	prices := BillingPrices{httpClient: httpClient, baseURL: u, apiKey: "pdl_sdbx_apikey"}
+
+	result, _, _, err := prices.List(context.Background(), &ListBillingPricesOptions{ProductIDs: []string{"pro_1", "pro_2"}, Recurring: Bool(false)})
+	ok(t, err)
+
+	equals(t, url.Values{"product_id": {"pro_1,pro_2"}, "recurring": {"false"}}, query)
+	equals(t, 0, len(result))
+}
+
+const billingPriceJSON = `{
+  "data": {
+    "id": "pri_01gsz8x8sawmvhz1pv30nge1ke",
+    "product_id": "pro_01gsz4t5hdjse780zja8vvr7jg",
+    "description": "Monthly",
+    "name": null,
+    "tax_mode": "account_setting",
+    "billing_cycle": {
+      "interval": "month",
+      "frequency": 1
+    },
+    "trial_period": null,
+    "unit_price": {
+      "amount": "3000",
+      "currency_code": "USD"
+    },
+    "quantity": {
+      "minimum": 1,
+      "maximum": 100
+    },
+    "status": "active",
+    "custom_data": null,
+    "created_at": "2023-02-23T13:55:22.538367Z",
+    "updated_at": "2023-02-23T13:55:22.538367Z"
+  },
+  "meta": {
+    "request_id": "5a1b2c3d-4e5f-6a7b-8c9d-0e1f2a3b4c5d"
+  }
+}`
@@ -0,0 +1,159 @@
+package paddle
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// BillingSubscriptions is an API to work with the Paddle Billing subscriptions.
+type BillingSubscriptions api
+
+// BillingSubscription represents a Paddle Billing subscription.
+type BillingSubscription struct {
+	ID                   string                     `json:"id"`
+	Status               string                     `json:"status"`
+	CustomerID           string                     `json:"customer_id"`
+	AddressID            string                     `json:"address_id"`
+	BusinessID           *string                    `json:"business_id"`
+	CurrencyCode         string                     `json:"currency_code"`
+	CollectionMode       string                     `json:"collection_mode"`
+	BillingCycle         BillingDuration            `json:"billing_cycle"`
+	CurrentBillingPeriod *BillingPeriod             `json:"current_billing_period"`
+	ScheduledChange      *BillingScheduledChange    `json:"scheduled_change"`
+	Items                []*BillingSubscriptionItem `json:"items"`
+	CustomData           map[string]interface{}     `json:"custom_data"`
+	CreatedAt            time.Time                  `json:"created_at"`
+	UpdatedAt            time.Time                  `json:"updated_at"`
+	StartedAt            *time.Time                 `json:"started_at"`
+	FirstBilledAt        *time.Time                 `json:"first_billed_at"`
+	NextBilledAt         *time.Time                 `json:"next_billed_at"`
+	PausedAt             *time.Time                 `json:"paused_at"`
+	CanceledAt           *time.Time                 `json:"canceled_at"`
+}
+
+// BillingPeriod represents a billing period of a subscription.
+type BillingPeriod struct {
+	StartsAt time.Time `json:"starts_at"`
+	EndsAt   time.Time `json:"ends_at"`
+}
+
+// BillingScheduledChange represents a change scheduled for a subscription, like a cancellation.
+type BillingScheduledChange struct {
+	Action      string     `json:"action"`
+	EffectiveAt time.Time  `json:"effective_at"`
+	ResumeAt    *time.Time `json:"resume_at"`
+}
+
+// BillingSubscriptionItem represents a price a subscription is billed for.
+type BillingSubscriptionItem struct {
+	Status       string        `json:"status"`
+	Quantity     int           `json:"quantity"`
+	Recurring    bool          `json:"recurring"`
+	Price        *BillingPrice `json:"price"`
+	NextBilledAt *time.Time    `json:"next_billed_at"`
+	CreatedAt    time.Time     `json:"created_at"`
+	UpdatedAt    time.Time     `json:"updated_at"`
+}
+
+// ListBillingSubscriptionsOptions represents the filters of the subscriptions list.
+type ListBillingSubscriptionsOptions struct {
+	BillingListOptions
+	IDs         []string
+	CustomerIDs []string
+	PriceIDs    []string
+	// Status is one of "active", "canceled", "past_due", "paused" or "trialing".
+	Status []string
+}
+
+// encodeURLValues encodes options as URL parameters.
+func (options *ListBillingSubscriptionsOptions) encodeURLValues() (url.Values, error) {
+	values := make(url.Values)
+	if err := options.BillingListOptions.encode(values); err != nil {
+		return nil, err
+	}
+	setList(values, "id", options.IDs)
+	setList(values, "customer_id", options.CustomerIDs)
+	setList(values, "price_id", options.PriceIDs)
+	setList(values, "status", options.Status)
+
+	return values, nil
+}
+
+// List lists subscriptions, the pagination cursor is returned in the metadata.
+//
+// Paddle docs: https://developer.paddle.com/api-reference/subscriptions/list-subscriptions
+func (subscriptions *BillingSubscriptions) List(ctx context.Context, options *ListBillingSubscriptionsOptions) ([]*BillingSubscription, *BillingMeta, *http.Response, error) {
+	path := "subscriptions"
+
+	if options == nil {
+		options = new(ListBillingSubscriptionsOptions)
+	}
+
+	return billingList[*BillingSubscription](ctx, (*api)(subscriptions), path, options)
+}
+
+// Iter returns an iterator over all subscriptions matching the options, starting from options.After.
+func (subscriptions *BillingSubscriptions) Iter(ctx context.Context, options *ListBillingSubscriptionsOptions, iteratorOptions ...IteratorOption) *Iterator[*BillingSubscription] {
+	var pageOptions ListBillingSubscriptionsOptions
+	if options != nil {
+		pageOptions = *options
+	}
+
+	return billingIter(ctx, func(ctx context.Context, after string) ([]*BillingSubscription, *BillingMeta, error) {
+		pageOptions := pageOptions
+		pageOptions.After = after
+		result, meta, _, err := subscriptions.List(ctx, &pageOptions)
+
+		return result, meta, err
+	}, pageOptions.After, iteratorOptions)
+}
+
+// Get returns the subscription by ID.
+//
+// Paddle docs: https://developer.paddle.com/api-reference/subscriptions/get-subscription
+func (subscriptions *BillingSubscriptions) Get(ctx context.Context, subscriptionID string) (*BillingSubscription, *http.Response, error) {
+	if subscriptionID == "" {
+		return nil, nil, errors.New("subscription ID is required")
+	}
+
+	path := "subscriptions/" + url.PathEscape(subscriptionID)
+
+	return billingDo[BillingSubscription](ctx, (*api)(subscriptions), http.MethodGet, path, nil, true)
+}
+
+// Effective moments of subscription changes.
+const (
+	EffectiveImmediately       = "immediately"
+	EffectiveNextBillingPeriod = "next_billing_period"
+)
+
+// CancelBillingSubscriptionOptions represents the options of the subscription cancellation.
+type CancelBillingSubscriptionOptions struct {
+	// EffectiveFrom is EffectiveNextBillingPeriod by default, or EffectiveImmediately.
+	EffectiveFrom string `json:"effective_from,omitempty"`
+}
+
+// Cancel cancels the subscription, or schedules the cancellation for the next billing period.
+//
+// Paddle docs: https://developer.paddle.com/api-reference/subscriptions/cancel-subscription
+func (subscriptions *BillingSubscriptions) Cancel(ctx context.Context, subscriptionID string, options *CancelBillingSubscriptionOptions) (*BillingSubscription, *http.Response, error) {
+	if subscriptionID == "" {
+		return nil, nil, errors.New("subscription ID is required")
+	}
+
+	path := "subscriptions/" + url.PathEscape(subscriptionID) + "/cancel"
+
+	if options == nil {
+		options = new(CancelBillingSubscriptionOptions)
+	}
+	switch options.EffectiveFrom {
+	case "", EffectiveImmediately, EffectiveNextBillingPeriod:
+	default:
+		return nil, nil, errors.New("\"effective_from\" must be one of \"immediately\", \"next_billing_period\"")
+	}
+
+	return billingDo[BillingSubscription](ctx, (*api)(subscriptions), http.MethodPost, path, options, true)
+}
@@ -0,0 +1,132 @@
+package paddle
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestBillingSubscriptionsGet(t *testing.T) {
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		equals(t, http.MethodGet, req.Method)
+		equals(t, "/subscriptions/sub_01h04vsc0qhwtsbsxh3422wjs4", req.URL.Path)
+
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBuffer([]byte(billingSubscriptionJSON))),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	u, _ := url.Parse(sandboxBillingBaseURL)
+	subscriptions := BillingSubscriptions{httpClient: httpClient, baseURL: u, apiKey: "pdl_sdbx_apikey"}
+
+	_, _, err := subscriptions.Get(context.Background(), "")
+	errorred(t, err, "subscription ID is required")
+
+	result, _, err := subscriptions.Get(context.Background(), "sub_01h04vsc0qhwtsbsxh3422wjs4")
+	ok(t, err)
+
+	equals(t, "sub_01h04vsc0qhwtsbsxh3422wjs4", result.ID)
+	equals(t, "active", result.Status)
+	equals(t, BillingDuration{Interval: "month", Frequency: 1}, result.BillingCycle)
+	equals(t, time.Date(2023, 6, 1, 13, 30, 50, 0, time.UTC), result.NextBilledAt.UTC())
+	equals(t, (*time.Time)(nil), result.CanceledAt)
+	equals(t, &BillingScheduledChange{Action: "cancel", EffectiveAt: time.Date(2023, 6, 1, 13, 30, 50, 0, time.UTC)}, result.ScheduledChange)
+	equals(t, 1, len(result.Items))
+	equals(t, "pri_01gsz8x8sawmvhz1pv30nge1ke", result.Items[0].Price.ID)
+	equals(t, BillingMoney{Amount: "3000", CurrencyCode: "USD"}, result.Items[0].Price.UnitPrice)
+}
+
+func TestBillingSubscriptionsCancel(t *testing.T) {
+	var body map[string]interface{}
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		equals(t, http.MethodPost, req.Method)
+		equals(t, "/subscriptions/sub_01h04vsc0qhwtsbsxh3422wjs4/cancel", req.URL.Path)
+		ok(t, json.NewDecoder(req.Body).Decode(&body))
+
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBuffer([]byte(billingSubscriptionJSON))),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	u, _ := url.Parse(sandboxBillingBaseURL)
+	subscriptions := BillingSubscriptions{httpClient: httpClient, baseURL: u, apiKey: "pdl_sdbx_apikey"}
+
+	_, _, err := subscriptions.Cancel(context.Background(), "sub_01h04vsc0qhwtsbsxh3422wjs4", &CancelBillingSubscriptionOptions{EffectiveFrom: "tomorrow"})
+	errorred(t, err, "\"effective_from\" must be one of")
+
+	_, _, err = subscriptions.Cancel(context.Background(), "sub_01h04vsc0qhwtsbsxh3422wjs4", &CancelBillingSubscriptionOptions{EffectiveFrom: EffectiveNextBillingPeriod})
+	ok(t, err)
+
+	equals(t, map[string]interface{}{"effective_from": "next_billing_period"}, body)
+}
+
+const billingSubscriptionJSON = `{
+  "data": {
+    "id": "sub_01h04vsc0qhwtsbsxh3422wjs4",
+    "status": "active",
+    "customer_id": "ctm_01h04vsbhqc62t8hmd4z3b578c",
+    "address_id": "add_01h04vsbhqc62t8hmd4z3b578c",
+    "business_id": null,
+    "currency_code": "USD",
+    "collection_mode": "automatic",
+    "billing_cycle": {
+      "interval": "month",
+      "frequency": 1
+    },
+    "current_billing_period": {
+      "starts_at": "2023-05-01T13:30:50Z",
+      "ends_at": "2023-06-01T13:30:50Z"
+    },
+    "scheduled_change": {
+      "action": "cancel",
+      "effective_at": "2023-06-01T13:30:50Z",
+      "resume_at": null
+    },
+    "items": [
+      {
+        "status": "active",
+        "quantity": 1,
+        "recurring": true,
+        "created_at": "2023-05-01T13:30:50Z",
+        "updated_at": "2023-05-01T13:30:50Z",
+        "next_billed_at": "2023-06-01T13:30:50Z",
+        "price": {
+          "id": "pri_01gsz8x8sawmvhz1pv30nge1ke",
+          "product_id": "pro_01gsz4t5hdjse780zja8vvr7jg",
+          "description": "Monthly",
+          "tax_mode": "account_setting",
+          "billing_cycle": {
+            "interval": "month",
+            "frequency": 1
+          },
+          "trial_period": null,
+          "unit_price": {
+            "amount": "3000",
+            "currency_code": "USD"
+          },
+          "status": "active"
+        }
+      }
+    ],
+    "custom_data": null,
+    "created_at": "2023-05-01T13:30:50Z",
+    "updated_at": "2023-05-15T09:12:04Z",
+    "started_at": "2023-05-01T13:30:50Z",
+    "first_billed_at": "2023-05-01T13:30:50Z",
+    "next_billed_at": "2023-06-01T13:30:50Z",
+    "paused_at": null,
+    "canceled_at": null
+  },
+  "meta": {
+    "request_id": "4c1c3c9d-2f5e-4a8b-9e5b-0a3b1c2d3e4f"
+  }
+}`
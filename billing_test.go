@@ -0,0 +1,140 @@
+package paddle
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestNewBillingClient(t *testing.T) {
+	client, err := NewBillingClient("pdl_live_apikey")
+	ok(t, err)
+	equals(t, "https://api.paddle.com/", client.Customers.baseURL.String())
+
+	client, err = NewBillingClient("pdl_sdbx_apikey", WithEnvironment(EnvironmentSandbox))
+	ok(t, err)
+	equals(t, "https://sandbox-api.paddle.com/", client.Prices.baseURL.String())
+
+	client, err = NewBillingClient("pdl_sdbx_apikey", WithBaseURL("http://localhost:8080/"))
+	ok(t, err)
+	equals(t, "http://localhost:8080/", client.Subscriptions.baseURL.String())
+
+	_, err = NewBillingClient("")
+	errorred(t, err, "API key can't be empty")
+
+	_, err = NewBillingClient("pdl_sdbx_apikey", WithCheckoutBaseURL("http://localhost:8081/"))
+	errorred(t, err, "checkout base URL is not supported by the Billing API")
+}
+
+func TestBillingRequestHeaders(t *testing.T) {
+	var request *http.Request
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		request = req
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBuffer([]byte(billingCustomerJSON))),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	client, err := NewBillingClient("pdl_sdbx_apikey", WithEnvironment(EnvironmentSandbox), WithHTTPClient(httpClient), WithUserAgent("my-agent/1.0"))
+	ok(t, err)
+
+	_, _, err = client.Customers.Get(context.Background(), "ctm_01h8441jn5pcwrfhwh78jqt8hk")
+	ok(t, err)
+
+	equals(t, "Bearer pdl_sdbx_apikey", request.Header.Get("Authorization"))
+	equals(t, "application/json", request.Header.Get("Accept"))
+	equals(t, "my-agent/1.0", request.Header.Get("User-Agent"))
+	equals(t, "https://sandbox-api.paddle.com/customers/ctm_01h8441jn5pcwrfhwh78jqt8hk", request.URL.String())
+}
+
+func TestBillingError(t *testing.T) {
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusNotFound,
+			Body:       ioutil.NopCloser(bytes.NewBuffer([]byte(billingNotFoundJSON))),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	client, err := NewBillingClient("pdl_sdbx_apikey", WithHTTPClient(httpClient), WithRetryPolicy(&RetryPolicy{MaxAttempts: 1}))
+	ok(t, err)
+
+	_, httpResponse, err := client.Customers.Get(context.Background(), "ctm_unknown")
+	equals(t, http.StatusNotFound, httpResponse.StatusCode)
+	equals(t, true, IsNotFound(err))
+
+	var billingError *BillingError
+	equals(t, true, errors.As(err, &billingError))
+	equals(t, "not_found", billingError.Code)
+	equals(t, "a7b8d4a1-4b6e-4d4f-8c3a-5b1b0c7b1f5e", billingError.RequestID)
+}
+
+func TestBillingErrorOnValidation(t *testing.T) {
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusBadRequest,
+			Body:       ioutil.NopCloser(bytes.NewBuffer([]byte(billingValidationJSON))),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	client, err := NewBillingClient("pdl_sdbx_apikey", WithHTTPClient(httpClient))
+	ok(t, err)
+
+	_, _, err = client.Customers.Create(context.Background(), &CreateBillingCustomerOptions{Email: "invalid"})
+	equals(t, true, IsValidationError(err))
+	errorred(t, err, "email: must be a valid email address")
+}
+
+func TestBillingErrorOnHTMLErrorPage(t *testing.T) {
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusBadGateway,
+			Body:       ioutil.NopCloser(bytes.NewBuffer([]byte("<html>Bad Gateway</html>"))),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	client, err := NewBillingClient("pdl_sdbx_apikey", WithHTTPClient(httpClient), WithRetryPolicy(&RetryPolicy{MaxAttempts: 1}))
+	ok(t, err)
+
+	_, _, err = client.Prices.Get(context.Background(), "pri_01gsz8x8sawmvhz1pv30nge1ke")
+	var httpError *HTTPError
+	equals(t, true, errors.As(err, &httpError))
+	equals(t, http.StatusBadGateway, httpError.StatusCode)
+}
+
+const billingNotFoundJSON = `{
+  "error": {
+    "type": "request_error",
+    "code": "not_found",
+    "detail": "Entity ctm_unknown not found",
+    "documentation_url": "https://developer.paddle.com/v1/errors/shared/not_found"
+  },
+  "meta": {
+    "request_id": "a7b8d4a1-4b6e-4d4f-8c3a-5b1b0c7b1f5e"
+  }
+}`
+
+const billingValidationJSON = `{
+  "error": {
+    "type": "request_error",
+    "code": "bad_request",
+    "detail": "Invalid request.",
+    "documentation_url": "https://developer.paddle.com/v1/errors/shared/bad_request",
+    "errors": [
+      {
+        "field": "email",
+        "message": "must be a valid email address"
+      }
+    ]
+  },
+  "meta": {
+    "request_id": "0f5b8a5e-8d40-4c35-9a3c-3f1b6f0a9c8e"
+  }
+}`
@@ -0,0 +1,127 @@
+package paddle
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// BillingTransactions is an API to work with the Paddle Billing transactions.
+type BillingTransactions api
+
+// BillingTransaction represents a Paddle Billing transaction.
+type BillingTransaction struct {
+	ID             string                     `json:"id"`
+	Status         string                     `json:"status"`
+	CustomerID     *string                    `json:"customer_id"`
+	AddressID      *string                    `json:"address_id"`
+	BusinessID     *string                    `json:"business_id"`
+	SubscriptionID *string                    `json:"subscription_id"`
+	InvoiceID      *string                    `json:"invoice_id"`
+	InvoiceNumber  *string                    `json:"invoice_number"`
+	Origin         string                     `json:"origin"`
+	CollectionMode string                     `json:"collection_mode"`
+	CurrencyCode   string                     `json:"currency_code"`
+	BillingPeriod  *BillingPeriod             `json:"billing_period"`
+	Items          []*BillingTransactionItem  `json:"items"`
+	Details        *BillingTransactionDetails `json:"details"`
+	CustomData     map[string]interface{}     `json:"custom_data"`
+	CreatedAt      time.Time                  `json:"created_at"`
+	UpdatedAt      time.Time                  `json:"updated_at"`
+	BilledAt       *time.Time                 `json:"billed_at"`
+}
+
+// BillingTransactionItem represents a price a transaction is billed for.
+type BillingTransactionItem struct {
+	Price    *BillingPrice `json:"price"`
+	Quantity int           `json:"quantity"`
+}
+
+// BillingTransactionDetails represents the calculated totals of a transaction.
+type BillingTransactionDetails struct {
+	Totals *BillingTransactionTotals `json:"totals"`
+}
+
+// BillingTransactionTotals represents the totals of a transaction in the lowest denomination of the currency.
+type BillingTransactionTotals struct {
+	Subtotal     string `json:"subtotal"`
+	Discount     string `json:"discount"`
+	Tax          string `json:"tax"`
+	Total        string `json:"total"`
+	Credit       string `json:"credit"`
+	Balance      string `json:"balance"`
+	GrandTotal   string `json:"grand_total"`
+	Fee          string `json:"fee"`
+	Earnings     string `json:"earnings"`
+	CurrencyCode string `json:"currency_code"`
+}
+
+// ListBillingTransactionsOptions represents the filters of the transactions list.
+type ListBillingTransactionsOptions struct {
+	BillingListOptions
+	IDs             []string
+	CustomerIDs     []string
+	SubscriptionIDs []string
+	InvoiceNumbers  []string
+	// Status is one of "draft", "ready", "billed", "paid", "completed", "canceled" or "past_due".
+	Status []string
+}
+
+// encodeURLValues encodes options as URL parameters.
+func (options *ListBillingTransactionsOptions) encodeURLValues() (url.Values, error) {
+	values := make(url.Values)
+	if err := options.BillingListOptions.encode(values); err != nil {
+		return nil, err
+	}
+	setList(values, "id", options.IDs)
+	setList(values, "customer_id", options.CustomerIDs)
+	setList(values, "subscription_id", options.SubscriptionIDs)
+	setList(values, "invoice_number", options.InvoiceNumbers)
+	setList(values, "status", options.Status)
+
+	return values, nil
+}
+
+// List lists transactions, the pagination cursor is returned in the metadata.
+//
+// Paddle docs: https://developer.paddle.com/api-reference/transactions/list-transactions
+func (transactions *BillingTransactions) List(ctx context.Context, options *ListBillingTransactionsOptions) ([]*BillingTransaction, *BillingMeta, *http.Response, error) {
+	path := "transactions"
+
+	if options == nil {
+		options = new(ListBillingTransactionsOptions)
+	}
+
+	return billingList[*BillingTransaction](ctx, (*api)(transactions), path, options)
+}
+
+// Iter returns an iterator over all transactions matching the options, starting from options.After.
+func (transactions *BillingTransactions) Iter(ctx context.Context, options *ListBillingTransactionsOptions, iteratorOptions ...IteratorOption) *Iterator[*BillingTransaction] {
+	var pageOptions ListBillingTransactionsOptions
+	if options != nil {
+		pageOptions = *options
+	}
+
+	return billingIter(ctx, func(ctx context.Context, after string) ([]*BillingTransaction, *BillingMeta, error) {
+		pageOptions := pageOptions
+		pageOptions.After = after
+		result, meta, _, err := transactions.List(ctx, &pageOptions)
+
+		return result, meta, err
+	}, pageOptions.After, iteratorOptions)
+}
+
+// Get returns the transaction by ID.
+//
+// Paddle docs: https://developer.paddle.com/api-reference/transactions/get-transaction
+func (transactions *BillingTransactions) Get(ctx context.Context, transactionID string) (*BillingTransaction, *http.Response, error) {
+	if transactionID == "" {
+		return nil, nil, errors.New("transaction ID is required")
+	}
+
+	path := "transactions/" + url.PathEscape(transactionID)
+
+	return billingDo[BillingTransaction](ctx, (*api)(transactions), http.MethodGet, path, nil, true)
+}
@@ -0,0 +1,107 @@
+package paddle
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestBillingTransactionsList(t *testing.T) {
+	var query url.Values
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		equals(t, http.MethodGet, req.Method)
+		equals(t, "/transactions", req.URL.Path)
+		query = req.URL.Query()
+
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBuffer([]byte(billingTransactionsListJSON))),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	u, _ := url.Parse(sandboxBillingBaseURL)
+	transactions := BillingTransactions{httpClient: httpClient, baseURL: u, apiKey: "pdl_sdbx_apikey"}
+
+	result, meta, _, err := transactions.List(context.Background(), &ListBillingTransactionsOptions{
+		SubscriptionIDs: []string{"sub_01h04vsc0qhwtsbsxh3422wjs4"},
+		Status:          []string{"completed"},
+	})
+	ok(t, err)
+
+	equals(t, url.Values{"subscription_id": {"sub_01h04vsc0qhwtsbsxh3422wjs4"}, "status": {"completed"}}, query)
+	equals(t, 1, len(result))
+	equals(t, "txn_01h04vsbhqc62t8hmd4z3b578c", result[0].ID)
+	equals(t, "sub_01h04vsc0qhwtsbsxh3422wjs4", *result[0].SubscriptionID)
+	equals(t, (*string)(nil), result[0].BusinessID)
+	equals(t, "3000", result[0].Details.Totals.GrandTotal)
+	equals(t, 2, result[0].Items[0].Quantity)
+	equals(t, "", meta.Pagination.after())
+}
+
+const billingTransactionsListJSON = `{
+  "data": [
+    {
+      "id": "txn_01h04vsbhqc62t8hmd4z3b578c",
+      "status": "completed",
+      "customer_id": "ctm_01h04vsbhqc62t8hmd4z3b578c",
+      "address_id": "add_01h04vsbhqc62t8hmd4z3b578c",
+      "business_id": null,
+      "subscription_id": "sub_01h04vsc0qhwtsbsxh3422wjs4",
+      "invoice_id": "inv_01h04vseghc8vs2v3ck3qzn0ks",
+      "invoice_number": "325-10566",
+      "origin": "subscription_recurring",
+      "collection_mode": "automatic",
+      "currency_code": "USD",
+      "billing_period": {
+        "starts_at": "2023-05-01T13:30:50Z",
+        "ends_at": "2023-06-01T13:30:50Z"
+      },
+      "items": [
+        {
+          "quantity": 2,
+          "price": {
+            "id": "pri_01gsz8x8sawmvhz1pv30nge1ke",
+            "product_id": "pro_01gsz4t5hdjse780zja8vvr7jg",
+            "description": "Monthly",
+            "unit_price": {
+              "amount": "1500",
+              "currency_code": "USD"
+            },
+            "status": "active"
+          }
+        }
+      ],
+      "details": {
+        "totals": {
+          "subtotal": "3000",
+          "discount": "0",
+          "tax": "0",
+          "total": "3000",
+          "credit": "0",
+          "balance": "0",
+          "grand_total": "3000",
+          "fee": "200",
+          "earnings": "2800",
+          "currency_code": "USD"
+        }
+      },
+      "custom_data": null,
+      "created_at": "2023-05-01T13:30:50Z",
+      "updated_at": "2023-05-01T13:31:02Z",
+      "billed_at": "2023-05-01T13:30:50Z"
+    }
+  ],
+  "meta": {
+    "request_id": "9e2c1a7f-3b5d-4c8e-a1f0-6d7b8c9e0a1b",
+    "pagination": {
+      "per_page": 50,
+      "next": "https://sandbox-api.paddle.com/transactions?after=txn_01h04vsbhqc62t8hmd4z3b578c",
+      "has_more": false,
+      "estimated_total": 1
+    }
+  }
+}`
@@ -8,9 +8,12 @@ import (
 	"net/url"
 )
 
-// Charges is an API to work with the Paddle subscription one-off charges.
+// Charges is an API to work with the Paddle one-off charges.
 type Charges api
 
+// maxChargeNameLength is the maximum length of the charge name accepted by Paddle.
+const maxChargeNameLength = 50
+
 // ChargeOptions represents options for charing.
 type ChargeOptions struct {
 	Amount     string
@@ -20,9 +23,9 @@ type ChargeOptions struct {
 // encodeURLValues encodes options as URL parameters.
 func (options *ChargeOptions) encodeURLValues() (url.Values, error) {
 	values := make(url.Values)
-
-	values.Set("amount", options.Amount)
-	values.Set("charge_name", options.ChargeName)
+	if err := setCharge(values, options.Amount, options.ChargeName); err != nil {
+		return nil, err
+	}
 
 	return values, nil
 }
@@ -38,10 +41,10 @@ type ChargeResponse struct {
 	Status         string `json:"status,omitempty"`
 }
 
-// Charge charges.
+// Charge charges the subscription.
 //
 // Paddle docs: https://developer.paddle.com/api-reference/23cf86225523f-create-one-off-charge
-func (modifiers *Charges) Charge(ctx context.Context, subscriptionID uint64, options *ChargeOptions) (*ChargeResponse, *http.Response, error) {
+func (charges *Charges) Charge(ctx context.Context, subscriptionID uint64, options *ChargeOptions) (*ChargeResponse, *http.Response, error) {
 	if subscriptionID == 0 {
 		return nil, nil, errors.New("\"subscription_id\" can't be zero")
 	}
@@ -51,16 +54,37 @@ func (modifiers *Charges) Charge(ctx context.Context, subscriptionID uint64, opt
 	if options == nil {
 		options = new(ChargeOptions)
 	}
-	request, err := newRequest(ctx, http.MethodPost, modifiers.baseURL, path, modifiers.authentication, options)
+	request, err := newRequest(ctx, http.MethodPost, charges.baseURL, path, charges.authentication, options)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to create new request: %w", err)
 	}
 
 	response := new(response[*ChargeResponse])
-	httpResponse, err := doRequest(modifiers.httpClient, request, response)
+	httpResponse, err := doNonIdempotentRequest((*api)(charges), request, response)
 	if err != nil {
 		return nil, httpResponse, err
 	}
 
-	return *response.Response, httpResponse, nil
+	return response.value(), httpResponse, nil
+}
+
+// setCharge validates and sets the charge amount and name URL parameters.
+func setCharge(values url.Values, amount string, chargeName string) error {
+	if amount == "" {
+		return errors.New("\"amount\" is required")
+	}
+	if err := validateAmount(amount); err != nil {
+		return fmt.Errorf("\"amount\" %w", err)
+	}
+	if chargeName == "" {
+		return errors.New("\"charge_name\" is required")
+	}
+	if len([]rune(chargeName)) > maxChargeNameLength {
+		return fmt.Errorf("\"charge_name\" can't be longer than %d characters", maxChargeNameLength)
+	}
+
+	values.Set("amount", amount)
+	values.Set("charge_name", chargeName)
+
+	return nil
 }
@@ -0,0 +1,74 @@
+package paddle
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestChargesChargeOnValidationError(t *testing.T) {
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		return nil, nil
+	})
+	u, _ := url.Parse(sandboxBaseURL)
+	charges := Charges{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}}
+
+	cases := map[string]*ChargeOptions{
+		"\"amount\" is required":                  {ChargeName: "Extra seats"},
+		"\"amount\" must be a decimal number":     {Amount: "ten", ChargeName: "Extra seats"},
+		"\"amount\" must be positive":             {Amount: "0.00", ChargeName: "Extra seats"},
+		"\"charge_name\" is required":             {Amount: "10.00"},
+		"\"charge_name\" can't be longer than 50": {Amount: "10.00", ChargeName: strings.Repeat("a", 51)},
+	}
+	for message, options := range cases {
+		_, _, err := charges.Charge(context.Background(), 42, options)
+		errorred(t, err, message)
+	}
+
+	_, _, err := charges.Charge(context.Background(), 0, &ChargeOptions{Amount: "10.00", ChargeName: "Extra seats"})
+	errorred(t, err, "\"subscription_id\" can't be zero")
+}
+
+func TestChargesChargeOnSuccess(t *testing.T) {
+	var path string
+	var form url.Values
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		ok(t, req.ParseForm())
+		path = req.URL.Path
+		form = req.PostForm
+
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBuffer([]byte(chargesChargeJSON))),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	u, _ := url.Parse(sandboxBaseURL)
+	charges := Charges{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}}
+
+	result, _, err := charges.Charge(context.Background(), 502198, &ChargeOptions{Amount: "10.00", ChargeName: "Extra seats"})
+	ok(t, err)
+
+	equals(t, "/api/2.0/subscription/502198/charge", path)
+	equals(t, "10.00", form.Get("amount"))
+	equals(t, "Extra seats", form.Get("charge_name"))
+	equals(t, &ChargeResponse{InvoiceID: 1234, SubscriptionID: 502198, Amount: "10.00", Currency: "USD", PaymentDate: "2022-06-14", ReceiptURL: "https://sandbox-my.paddle.com/receipt/1234/chre8a3e7bd0ef6", Status: "success"}, result)
+}
+
+const chargesChargeJSON = `{
+    "success": true,
+    "response": {
+        "invoice_id": 1234,
+        "subscription_id": 502198,
+        "amount": "10.00",
+        "currency": "USD",
+        "payment_date": "2022-06-14",
+        "receipt_url": "https://sandbox-my.paddle.com/receipt/1234/chre8a3e7bd0ef6",
+        "status": "success"
+    }
+}`
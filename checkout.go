@@ -0,0 +1,195 @@
+package paddle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Orders is an API to work with the Paddle checkout orders.
+type Orders api
+
+// Order represents the checkout order information.
+type Order struct {
+	State    string         `json:"state,omitempty"`
+	Checkout *OrderCheckout `json:"checkout,omitempty"`
+	Order    *OrderDetails  `json:"order,omitempty"`
+	Lockers  []*OrderLocker `json:"lockers,omitempty"`
+}
+
+// OrderCheckout represents the checkout of an order.
+type OrderCheckout struct {
+	CheckoutID string `json:"checkout_id,omitempty"`
+	ImageURL   string `json:"image_url,omitempty"`
+	Title      string `json:"title,omitempty"`
+}
+
+// OrderDetails represents the details of a processed order.
+type OrderDetails struct {
+	OrderID                    uint64         `json:"order_id,omitempty"`
+	Total                      string         `json:"total,omitempty"`
+	TotalTax                   string         `json:"total_tax,omitempty"`
+	Currency                   string         `json:"currency,omitempty"`
+	FormattedTotal             string         `json:"formatted_total,omitempty"`
+	FormattedTax               string         `json:"formatted_tax,omitempty"`
+	CouponCode                 string         `json:"coupon_code,omitempty"`
+	ReceiptURL                 string         `json:"receipt_url,omitempty"`
+	CustomerSuccessRedirectURL string         `json:"customer_success_redirect_url,omitempty"`
+	HasLocker                  bool           `json:"has_locker,omitempty"`
+	IsSubscription             bool           `json:"is_subscription,omitempty"`
+	ProductID                  uint64         `json:"product_id,omitempty"`
+	SubscriptionID             uint64         `json:"subscription_id,omitempty"`
+	SubscriptionOrderID        string         `json:"subscription_order_id,omitempty"`
+	Quantity                   int            `json:"quantity,omitempty"`
+	Completed                  *OrderDate     `json:"completed,omitempty"`
+	Customer                   *OrderCustomer `json:"customer,omitempty"`
+}
+
+// OrderDate represents the date of an order.
+type OrderDate struct {
+	Date         string `json:"date,omitempty"`
+	TimezoneType int    `json:"timezone_type,omitempty"`
+	Timezone     string `json:"timezone,omitempty"`
+}
+
+// OrderCustomer represents the customer of an order.
+type OrderCustomer struct {
+	Email            string `json:"email,omitempty"`
+	MarketingConsent bool   `json:"marketing_consent,omitempty"`
+}
+
+// OrderLocker represents a locker with the fulfillment information, like a license code or a download link.
+type OrderLocker struct {
+	LockerID     uint64 `json:"locker_id,omitempty"`
+	ProductID    uint64 `json:"product_id,omitempty"`
+	ProductName  string `json:"product_name,omitempty"`
+	LicenseCode  string `json:"license_code,omitempty"`
+	Instructions string `json:"instructions,omitempty"`
+	Download     string `json:"download,omitempty"`
+}
+
+// getOrderOptions represents options for getting the order information.
+type getOrderOptions struct {
+	checkoutID string
+}
+
+// encodeURLValues encodes options as URL parameters.
+func (options *getOrderOptions) encodeURLValues() (url.Values, error) {
+	values := make(url.Values)
+	if options.checkoutID == "" {
+		return nil, errors.New("\"checkout_id\" is required")
+	}
+
+	values.Set("checkout_id", options.checkoutID)
+
+	return values, nil
+}
+
+// Get returns the order information of the checkout.
+//
+// Paddle docs: https://developer.paddle.com/api-reference/checkout-api/order-information/getorder
+func (orders *Orders) Get(ctx context.Context, checkoutID string) (*Order, *http.Response, error) {
+	path := "1.0/order"
+
+	request, err := newRequest(ctx, http.MethodGet, orders.baseURL, path, orders.authentication, &getOrderOptions{checkoutID: checkoutID})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create new request: %w", err)
+	}
+
+	response := new(rawResponse[*Order])
+	httpResponse, err := doRequest((*api)(orders), request, response)
+	if err != nil {
+		return nil, httpResponse, err
+	}
+
+	return response.Response, httpResponse, nil
+}
+
+// Prices is an API to work with the Paddle localized product prices.
+type Prices api
+
+// GetPricesOptions represents options for getting localized product prices.
+type GetPricesOptions struct {
+	ProductIDs []uint64
+	// CustomerCountry is a two-letter ISO country code, it takes precedence over CustomerIP.
+	CustomerCountry string
+	// CustomerIP is used to detect the customer country.
+	CustomerIP string
+	Coupons    []string
+}
+
+// encodeURLValues encodes options as URL parameters.
+func (options *GetPricesOptions) encodeURLValues() (url.Values, error) {
+	values := make(url.Values)
+	if len(options.ProductIDs) == 0 {
+		return nil, errors.New("\"product_ids\" is required")
+	}
+
+	setProductIDs(values, options.ProductIDs)
+	setString(values, "customer_country", options.CustomerCountry)
+	setString(values, "customer_ip", options.CustomerIP)
+	if len(options.Coupons) > 0 {
+		values.Set("coupons", strings.Join(options.Coupons, ","))
+	}
+
+	return values, nil
+}
+
+// GetPricesResponse represents a response for the get prices request.
+type GetPricesResponse struct {
+	CustomerCountry string          `json:"customer_country,omitempty"`
+	Products        []*ProductPrice `json:"products,omitempty"`
+}
+
+// ProductPrice represents the product price localized for the customer.
+type ProductPrice struct {
+	ProductID                  uint64               `json:"product_id,omitempty"`
+	ProductTitle               string               `json:"product_title,omitempty"`
+	Currency                   string               `json:"currency,omitempty"`
+	VendorSetPricesIncludedTax bool                 `json:"vendor_set_prices_included_tax,omitempty"`
+	Price                      *Price               `json:"price,omitempty"`
+	ListPrice                  *Price               `json:"list_price,omitempty"`
+	Subscription               *SubscriptionPricing `json:"subscription,omitempty"`
+}
+
+// Price represents a price with the tax details.
+type Price struct {
+	Gross float64 `json:"gross,omitempty"`
+	Net   float64 `json:"net,omitempty"`
+	Tax   float64 `json:"tax,omitempty"`
+}
+
+// SubscriptionPricing represents the recurring price of a subscription product.
+type SubscriptionPricing struct {
+	TrialDays int         `json:"trial_days,omitempty"`
+	Interval  BillingType `json:"interval,omitempty"`
+	Frequency int         `json:"frequency,omitempty"`
+	Price     *Price      `json:"price,omitempty"`
+	ListPrice *Price      `json:"list_price,omitempty"`
+}
+
+// Get returns the product prices localized for the customer.
+//
+// Paddle docs: https://developer.paddle.com/api-reference/checkout-api/prices/getprices
+func (prices *Prices) Get(ctx context.Context, options *GetPricesOptions) (*GetPricesResponse, *http.Response, error) {
+	path := "2.0/prices"
+
+	if options == nil {
+		options = new(GetPricesOptions)
+	}
+	request, err := newRequest(ctx, http.MethodGet, prices.baseURL, path, prices.authentication, options)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create new request: %w", err)
+	}
+
+	response := new(response[*GetPricesResponse])
+	httpResponse, err := doRequest((*api)(prices), request, response)
+	if err != nil {
+		return nil, httpResponse, err
+	}
+
+	return response.value(), httpResponse, nil
+}
@@ -0,0 +1,222 @@
+package paddle
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestCheckoutBaseURLs(t *testing.T) {
+	client, err := NewSandboxClient(Authentication{VendorID: 42, VendorAuthCode: "abc"})
+	ok(t, err)
+
+	equals(t, "https://sandbox-checkout.paddle.com/api/", client.Orders.baseURL.String())
+	equals(t, "https://sandbox-checkout.paddle.com/api/", client.Prices.baseURL.String())
+	equals(t, (*Authentication)(nil), client.Orders.authentication)
+
+	client, err = NewClient(Authentication{VendorID: 42, VendorAuthCode: "abc"}, WithCheckoutBaseURL("http://localhost:8081/api/"))
+	ok(t, err)
+
+	equals(t, "http://localhost:8081/api/", client.Prices.baseURL.String())
+	equals(t, "https://vendors.paddle.com/api/", client.Users.baseURL.String())
+}
+
+func TestOrdersGetOnValidationError(t *testing.T) {
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		return nil, nil
+	})
+	u, _ := url.Parse(sandboxCheckoutBaseURL)
+	orders := Orders{httpClient: httpClient, baseURL: u}
+
+	_, _, err := orders.Get(context.Background(), "")
+	errorred(t, err, "\"checkout_id\" is required")
+}
+
+func TestOrdersGetOnSuccess(t *testing.T) {
+	var request *http.Request
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		request = req
+
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBuffer([]byte(ordersGetJSON))),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	u, _ := url.Parse(sandboxCheckoutBaseURL)
+	orders := Orders{httpClient: httpClient, baseURL: u}
+
+	result, _, err := orders.Get(context.Background(), "7814479-chre0e6f7ff6b4d-34f2e6e1b0")
+	ok(t, err)
+
+	equals(t, http.MethodGet, request.Method)
+	equals(t, "https://sandbox-checkout.paddle.com/api/1.0/order?checkout_id=7814479-chre0e6f7ff6b4d-34f2e6e1b0", request.URL.String())
+	equals(t, "processed", result.State)
+	equals(t, "7814479-chre0e6f7ff6b4d-34f2e6e1b0", result.Checkout.CheckoutID)
+	equals(t, &OrderCustomer{Email: "qa@screenshotone.com", MarketingConsent: true}, result.Order.Customer)
+	equals(t, "https://sandbox-my.paddle.com/receipt/1042907-384786/7814479-chre0e6f7ff6b4d-34f2e6e1b0", result.Order.ReceiptURL)
+	equals(t, &OrderDate{Date: "2022-06-14 13:38:20.000000", TimezoneType: 3, Timezone: "UTC"}, result.Order.Completed)
+	equals(t, []*OrderLocker{{LockerID: 1127139, ProductID: 514032, ProductName: "Desktop app", LicenseCode: "D6A1B3E6-1B5C3A1F", Instructions: "Enter the license code in the app.", Download: "https://example.com/download"}}, result.Lockers)
+}
+
+func TestOrdersGetOnHTTPError(t *testing.T) {
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusNotFound,
+			Body:       ioutil.NopCloser(bytes.NewBuffer([]byte("Not Found"))),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	u, _ := url.Parse(sandboxCheckoutBaseURL)
+	orders := Orders{httpClient: httpClient, baseURL: u}
+
+	_, _, err := orders.Get(context.Background(), "unknown")
+	equals(t, true, IsNotFound(err))
+}
+
+func TestPricesGetOnSuccess(t *testing.T) {
+	var request *http.Request
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		request = req
+
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBuffer([]byte(pricesGetJSON))),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	u, _ := url.Parse(sandboxCheckoutBaseURL)
+	prices := Prices{httpClient: httpClient, baseURL: u}
+
+	result, _, err := prices.Get(context.Background(), &GetPricesOptions{ProductIDs: []uint64{514032, 26100}, CustomerCountry: "GB", Coupons: []string{"SPRING"}})
+	ok(t, err)
+
+	equals(t, "coupons=SPRING&customer_country=GB&product_ids=514032%2C26100", request.URL.RawQuery)
+	equals(t, "GB", result.CustomerCountry)
+	equals(t, &ProductPrice{
+		ProductID:                  26100,
+		ProductTitle:               "Monthly subscription",
+		Currency:                   "GBP",
+		VendorSetPricesIncludedTax: true,
+		Price:                      &Price{Gross: 6, Net: 5, Tax: 1},
+		ListPrice:                  &Price{Gross: 6, Net: 5, Tax: 1},
+		Subscription: &SubscriptionPricing{
+			TrialDays: 14,
+			Interval:  BillingMonth,
+			Frequency: 1,
+			Price:     &Price{Gross: 6, Net: 5, Tax: 1},
+			ListPrice: &Price{Gross: 6, Net: 5, Tax: 1},
+		},
+	}, result.Products[1])
+
+	_, _, err = prices.Get(context.Background(), nil)
+	errorred(t, err, "\"product_ids\" is required")
+}
+
+const ordersGetJSON = `{
+    "checkout": {
+        "checkout_id": "7814479-chre0e6f7ff6b4d-34f2e6e1b0",
+        "image_url": "https://paddle.s3.amazonaws.com/user/165798/bT1XUOJAQhOUxGs83cbk_APPIcon.png",
+        "title": "Desktop app"
+    },
+    "lockers": [
+        {
+            "download": "https://example.com/download",
+            "instructions": "Enter the license code in the app.",
+            "license_code": "D6A1B3E6-1B5C3A1F",
+            "locker_id": 1127139,
+            "product_id": 514032,
+            "product_name": "Desktop app"
+        }
+    ],
+    "order": {
+        "completed": {
+            "date": "2022-06-14 13:38:20.000000",
+            "timezone": "UTC",
+            "timezone_type": 3
+        },
+        "coupon_code": null,
+        "currency": "USD",
+        "customer": {
+            "email": "qa@screenshotone.com",
+            "marketing_consent": true
+        },
+        "customer_success_redirect_url": "",
+        "formatted_tax": "$0.83",
+        "formatted_total": "$5.00",
+        "has_locker": true,
+        "is_subscription": false,
+        "order_id": 1042907,
+        "product_id": 514032,
+        "quantity": 1,
+        "receipt_url": "https://sandbox-my.paddle.com/receipt/1042907-384786/7814479-chre0e6f7ff6b4d-34f2e6e1b0",
+        "subscription_id": null,
+        "subscription_order_id": null,
+        "total": "5.00",
+        "total_tax": "0.83"
+    },
+    "state": "processed"
+}`
+
+const pricesGetJSON = `{
+    "success": true,
+    "response": {
+        "customer_country": "GB",
+        "products": [
+            {
+                "product_id": 514032,
+                "product_title": "Desktop app",
+                "currency": "GBP",
+                "vendor_set_prices_included_tax": false,
+                "price": {
+                    "gross": 4.8,
+                    "net": 4,
+                    "tax": 0.8
+                },
+                "list_price": {
+                    "gross": 4.8,
+                    "net": 4,
+                    "tax": 0.8
+                },
+                "subscription": null
+            },
+            {
+                "product_id": 26100,
+                "product_title": "Monthly subscription",
+                "currency": "GBP",
+                "vendor_set_prices_included_tax": true,
+                "price": {
+                    "gross": 6,
+                    "net": 5,
+                    "tax": 1
+                },
+                "list_price": {
+                    "gross": 6,
+                    "net": 5,
+                    "tax": 1
+                },
+                "subscription": {
+                    "trial_days": 14,
+                    "interval": "month",
+                    "frequency": 1,
+                    "price": {
+                        "gross": 6,
+                        "net": 5,
+                        "tax": 1
+                    },
+                    "list_price": {
+                        "gross": 6,
+                        "net": 5,
+                        "tax": 1
+                    }
+                }
+            }
+        ]
+    }
+}`
@@ -0,0 +1,341 @@
+package paddle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Coupons is an API to work with the Paddle coupons.
+type Coupons api
+
+// DiscountType represents coupon discount type: flat or percentage.
+type DiscountType string
+
+const (
+	// DiscountFlat represents a fixed amount discount in the specified currency.
+	DiscountFlat DiscountType = "flat"
+	// DiscountPercentage represents a percentage discount.
+	DiscountPercentage DiscountType = "percentage"
+)
+
+// CouponType represents coupon type: product or checkout.
+type CouponType string
+
+const (
+	// CouponProduct represents a coupon applicable to the specified products only.
+	CouponProduct CouponType = "product"
+	// CouponCheckout represents a coupon applicable to any checkout.
+	CouponCheckout CouponType = "checkout"
+)
+
+// Coupon represents a Paddle coupon.
+type Coupon struct {
+	Coupon           string       `json:"coupon,omitempty"`
+	Description      string       `json:"description,omitempty"`
+	DiscountType     DiscountType `json:"discount_type,omitempty"`
+	DiscountAmount   float64      `json:"discount_amount,omitempty"`
+	DiscountCurrency string       `json:"discount_currency,omitempty"`
+	AllowedUses      int          `json:"allowed_uses,omitempty"`
+	TimesUsed        int          `json:"times_used,omitempty"`
+	IsRecurring      bool         `json:"is_recurring,omitempty"`
+	Expires          string       `json:"expires,omitempty"`
+}
+
+// ListCouponsOptions represents options for listing coupons.
+type ListCouponsOptions struct {
+	ProductID uint64
+}
+
+// encodeURLValues encodes options as URL parameters.
+func (options *ListCouponsOptions) encodeURLValues() (url.Values, error) {
+	values := make(url.Values)
+	if options.ProductID == 0 {
+		return nil, errors.New("\"product_id\" is required")
+	}
+
+	values.Set("product_id", strconv.FormatUint(options.ProductID, 10))
+
+	return values, nil
+}
+
+// List returns coupons available for the product.
+//
+// Paddle docs: https://developer.paddle.com/api-reference/product-api/coupons/listcoupons
+func (coupons *Coupons) List(ctx context.Context, options *ListCouponsOptions) ([]*Coupon, *http.Response, error) {
+	path := "2.0/product/list_coupons"
+
+	if options == nil {
+		options = new(ListCouponsOptions)
+	}
+	request, err := newRequest(ctx, http.MethodPost, coupons.baseURL, path, coupons.authentication, options)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create new request: %w", err)
+	}
+
+	response := new(response[[]*Coupon])
+	httpResponse, err := doRequest((*api)(coupons), request, response)
+	if err != nil {
+		return nil, httpResponse, err
+	}
+
+	return response.value(), httpResponse, nil
+}
+
+// CreateCouponOptions represents options for creating coupons.
+type CreateCouponOptions struct {
+	CouponType     CouponType
+	DiscountType   DiscountType
+	DiscountAmount string
+	// Currency is required for flat discounts.
+	Currency string
+	// ProductIDs is required for product coupons.
+	ProductIDs  []uint64
+	AllowedUses int
+	Expires     time.Time
+	Recurring   bool
+	Group       string
+	// CouponCode is the code of a single coupon, otherwise NumCoupons random codes with CouponPrefix are generated.
+	CouponCode   string
+	CouponPrefix string
+	NumCoupons   int
+	Description  string
+}
+
+// encodeURLValues encodes options as URL parameters.
+func (options *CreateCouponOptions) encodeURLValues() (url.Values, error) {
+	values := make(url.Values)
+
+	switch options.CouponType {
+	case CouponProduct:
+		if len(options.ProductIDs) == 0 {
+			return nil, errors.New("\"product_ids\" is required for product coupons")
+		}
+	case CouponCheckout:
+	default:
+		return nil, errors.New("\"coupon_type\" must be one of \"product\", \"checkout\"")
+	}
+	values.Set("coupon_type", string(options.CouponType))
+
+	if err := setDiscount(values, options.DiscountType, options.DiscountAmount, options.Currency); err != nil {
+		return nil, err
+	}
+
+	if options.CouponCode != "" && (options.CouponPrefix != "" || options.NumCoupons > 0) {
+		return nil, errors.New("\"coupon_code\" can't be used with \"coupon_prefix\" or \"num_coupons\"")
+	}
+	if options.AllowedUses < 0 {
+		return nil, errors.New("\"allowed_uses\" can't be negative")
+	}
+	if options.NumCoupons < 0 {
+		return nil, errors.New("\"num_coupons\" can't be negative")
+	}
+
+	setProductIDs(values, options.ProductIDs)
+	if options.AllowedUses > 0 {
+		values.Set("allowed_uses", strconv.Itoa(options.AllowedUses))
+	}
+	if !options.Expires.IsZero() {
+		values.Set("expires", options.Expires.Format("2006-01-02"))
+	}
+	if options.Recurring {
+		values.Set("recurring", "1")
+	} else {
+		values.Set("recurring", "0")
+	}
+	setString(values, "group", options.Group)
+	setString(values, "coupon_code", options.CouponCode)
+	setString(values, "coupon_prefix", options.CouponPrefix)
+	if options.NumCoupons > 0 {
+		values.Set("num_coupons", strconv.Itoa(options.NumCoupons))
+	}
+	setString(values, "description", options.Description)
+
+	return values, nil
+}
+
+// CreateCouponResponse represents a response for the create coupon request.
+type CreateCouponResponse struct {
+	CouponCodes []string `json:"coupon_codes,omitempty"`
+}
+
+// Create creates one or more coupons.
+//
+// Paddle docs: https://developer.paddle.com/api-reference/product-api/coupons/createcoupon
+func (coupons *Coupons) Create(ctx context.Context, options *CreateCouponOptions) (*CreateCouponResponse, *http.Response, error) {
+	path := "2.1/product/create_coupon"
+
+	if options == nil {
+		options = new(CreateCouponOptions)
+	}
+	request, err := newRequest(ctx, http.MethodPost, coupons.baseURL, path, coupons.authentication, options)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create new request: %w", err)
+	}
+
+	response := new(response[*CreateCouponResponse])
+	httpResponse, err := doNonIdempotentRequest((*api)(coupons), request, response)
+	if err != nil {
+		return nil, httpResponse, err
+	}
+
+	return response.value(), httpResponse, nil
+}
+
+// UpdateCouponOptions represents options for updating coupons, either CouponCode or Group is required.
+type UpdateCouponOptions struct {
+	CouponCode    string
+	Group         string
+	NewCouponCode string
+	NewGroup      string
+	ProductIDs    []uint64
+	Expires       time.Time
+	AllowedUses   int
+	// Currency is required when DiscountAmount of a flat coupon is updated.
+	Currency       string
+	DiscountAmount string
+	Recurring      *bool
+}
+
+// encodeURLValues encodes options as URL parameters.
+func (options *UpdateCouponOptions) encodeURLValues() (url.Values, error) {
+	values := make(url.Values)
+	if options.CouponCode == "" && options.Group == "" {
+		return nil, errors.New("\"coupon_code\" or \"group\" is required")
+	}
+	if options.CouponCode != "" && options.Group != "" {
+		return nil, errors.New("only one of \"coupon_code\" and \"group\" can be specified")
+	}
+	if options.AllowedUses < 0 {
+		return nil, errors.New("\"allowed_uses\" can't be negative")
+	}
+	if options.DiscountAmount != "" {
+		if err := validateAmount(options.DiscountAmount); err != nil {
+			return nil, fmt.Errorf("\"discount_amount\" %w", err)
+		}
+	}
+
+	setString(values, "coupon_code", options.CouponCode)
+	setString(values, "group", options.Group)
+	setString(values, "new_coupon_code", options.NewCouponCode)
+	setString(values, "new_group", options.NewGroup)
+	setProductIDs(values, options.ProductIDs)
+	if !options.Expires.IsZero() {
+		values.Set("expires", options.Expires.Format("2006-01-02"))
+	}
+	if options.AllowedUses > 0 {
+		values.Set("allowed_uses", strconv.Itoa(options.AllowedUses))
+	}
+	setString(values, "currency", options.Currency)
+	setString(values, "discount_amount", options.DiscountAmount)
+	if options.Recurring != nil {
+		if *options.Recurring {
+			values.Set("recurring", "1")
+		} else {
+			values.Set("recurring", "0")
+		}
+	}
+
+	return values, nil
+}
+
+// UpdateCouponResponse represents a response for the update coupon request.
+type UpdateCouponResponse struct {
+	Updated int `json:"updated,omitempty"`
+}
+
+// Update updates a coupon or a group of coupons.
+//
+// Paddle docs: https://developer.paddle.com/api-reference/product-api/coupons/updatecoupon
+func (coupons *Coupons) Update(ctx context.Context, options *UpdateCouponOptions) (*UpdateCouponResponse, *http.Response, error) {
+	path := "2.1/product/update_coupon"
+
+	if options == nil {
+		options = new(UpdateCouponOptions)
+	}
+	request, err := newRequest(ctx, http.MethodPost, coupons.baseURL, path, coupons.authentication, options)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create new request: %w", err)
+	}
+
+	response := new(response[*UpdateCouponResponse])
+	httpResponse, err := doRequest((*api)(coupons), request, response)
+	if err != nil {
+		return nil, httpResponse, err
+	}
+
+	return response.value(), httpResponse, nil
+}
+
+// DeleteCouponOptions represents options for deleting a coupon.
+type DeleteCouponOptions struct {
+	CouponCode string
+	ProductID  uint64
+}
+
+// encodeURLValues encodes options as URL parameters.
+func (options *DeleteCouponOptions) encodeURLValues() (url.Values, error) {
+	values := make(url.Values)
+	if options.CouponCode == "" {
+		return nil, errors.New("\"coupon_code\" is required")
+	}
+
+	values.Set("coupon_code", options.CouponCode)
+	if options.ProductID != 0 {
+		values.Set("product_id", strconv.FormatUint(options.ProductID, 10))
+	}
+
+	return values, nil
+}
+
+// Delete deletes the coupon.
+//
+// Paddle docs: https://developer.paddle.com/api-reference/product-api/coupons/deletecoupon
+func (coupons *Coupons) Delete(ctx context.Context, options *DeleteCouponOptions) (*http.Response, error) {
+	path := "2.0/product/delete_coupon"
+
+	if options == nil {
+		options = new(DeleteCouponOptions)
+	}
+	request, err := newRequest(ctx, http.MethodPost, coupons.baseURL, path, coupons.authentication, options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create new request: %w", err)
+	}
+
+	httpResponse, err := doRequest((*api)(coupons), request, new(response[interface{}]))
+	if err != nil {
+		return httpResponse, err
+	}
+
+	return httpResponse, nil
+}
+
+// setDiscount validates and sets the discount URL parameters.
+func setDiscount(values url.Values, discountType DiscountType, amount string, currency string) error {
+	switch discountType {
+	case DiscountFlat:
+		if currency == "" {
+			return errors.New("\"currency\" is required for flat discounts")
+		}
+	case DiscountPercentage:
+	default:
+		return errors.New("\"discount_type\" must be one of \"flat\", \"percentage\"")
+	}
+
+	if amount == "" {
+		return errors.New("\"discount_amount\" is required")
+	}
+	if err := validateAmount(amount); err != nil {
+		return fmt.Errorf("\"discount_amount\" %w", err)
+	}
+
+	values.Set("discount_type", string(discountType))
+	values.Set("discount_amount", amount)
+	setString(values, "currency", currency)
+
+	return nil
+}
@@ -0,0 +1,225 @@
+package paddle
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestCouponsListOnValidationError(t *testing.T) {
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		return nil, nil
+	})
+	u, _ := url.Parse(sandboxBaseURL)
+	coupons := Coupons{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}}
+
+	_, _, err := coupons.List(context.Background(), nil)
+	errorred(t, err, "\"product_id\" is required")
+}
+
+func TestCouponsListOnSuccess(t *testing.T) {
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBuffer([]byte(couponsListJSON))),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	u, _ := url.Parse(sandboxBaseURL)
+	coupons := Coupons{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}}
+
+	result, _, err := coupons.List(context.Background(), &ListCouponsOptions{ProductID: 12345})
+	ok(t, err)
+
+	equals(t, []*Coupon{
+		{Coupon: "56604810", Description: "Spring sale", DiscountType: DiscountPercentage, DiscountAmount: 0.3, AllowedUses: 1, TimesUsed: 0, IsRecurring: false, Expires: "2022-12-31 00:00:00"},
+		{Coupon: "FLAT10", DiscountType: DiscountFlat, DiscountAmount: 10, DiscountCurrency: "USD", AllowedUses: 100, TimesUsed: 12, IsRecurring: true},
+	}, result)
+}
+
+func TestCouponsCreateOnValidationError(t *testing.T) {
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		return nil, nil
+	})
+	u, _ := url.Parse(sandboxBaseURL)
+	coupons := Coupons{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}}
+
+	cases := map[string]*CreateCouponOptions{
+		"\"coupon_type\" must be one of":          {DiscountType: DiscountPercentage, DiscountAmount: "10"},
+		"\"product_ids\" is required":             {CouponType: CouponProduct, DiscountType: DiscountPercentage, DiscountAmount: "10"},
+		"\"discount_type\" must be one of":        {CouponType: CouponCheckout, DiscountAmount: "10"},
+		"\"currency\" is required":                {CouponType: CouponCheckout, DiscountType: DiscountFlat, DiscountAmount: "10"},
+		"\"discount_amount\" is required":         {CouponType: CouponCheckout, DiscountType: DiscountPercentage},
+		"\"discount_amount\" must be a decimal":   {CouponType: CouponCheckout, DiscountType: DiscountPercentage, DiscountAmount: "ten"},
+		"\"coupon_code\" can't be used with":      {CouponType: CouponCheckout, DiscountType: DiscountPercentage, DiscountAmount: "10", CouponCode: "SPRING", NumCoupons: 5},
+		"\"allowed_uses\" can't be negative":      {CouponType: CouponCheckout, DiscountType: DiscountPercentage, DiscountAmount: "10", AllowedUses: -1},
+		"\"discount_amount\" must be positive":    {CouponType: CouponCheckout, DiscountType: DiscountPercentage, DiscountAmount: "0"},
+		"\"num_coupons\" can't be negative":       {CouponType: CouponCheckout, DiscountType: DiscountPercentage, DiscountAmount: "10", NumCoupons: -1},
+		"\"product_ids\" is required for product": {CouponType: CouponProduct, DiscountType: DiscountFlat, DiscountAmount: "10", Currency: "USD"},
+	}
+	for message, options := range cases {
+		_, _, err := coupons.Create(context.Background(), options)
+		errorred(t, err, message)
+	}
+}
+
+func TestCouponsCreateOnSuccess(t *testing.T) {
+	var form url.Values
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		ok(t, req.ParseForm())
+		form = req.PostForm
+
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBuffer([]byte(couponsCreateJSON))),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	u, _ := url.Parse(sandboxBaseURL)
+	coupons := Coupons{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}}
+
+	result, _, err := coupons.Create(context.Background(), &CreateCouponOptions{
+		CouponType:     CouponProduct,
+		DiscountType:   DiscountFlat,
+		DiscountAmount: "10.00",
+		Currency:       "USD",
+		ProductIDs:     []uint64{12345, 67890},
+		AllowedUses:    1,
+		Expires:        time.Date(2022, 12, 31, 0, 0, 0, 0, time.UTC),
+		Recurring:      true,
+		Group:          "spring",
+		CouponPrefix:   "SPRING",
+		NumCoupons:     2,
+	})
+	ok(t, err)
+
+	equals(t, &CreateCouponResponse{CouponCodes: []string{"SPRING-0C5E7A3D", "SPRING-5D8B6E7F"}}, result)
+	equals(t, "product", form.Get("coupon_type"))
+	equals(t, "flat", form.Get("discount_type"))
+	equals(t, "12345,67890", form.Get("product_ids"))
+	equals(t, "2022-12-31", form.Get("expires"))
+	equals(t, "1", form.Get("recurring"))
+	equals(t, "2", form.Get("num_coupons"))
+}
+
+func TestCouponsUpdateOnValidationError(t *testing.T) {
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		return nil, nil
+	})
+	u, _ := url.Parse(sandboxBaseURL)
+	coupons := Coupons{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}}
+
+	_, _, err := coupons.Update(context.Background(), &UpdateCouponOptions{})
+	errorred(t, err, "\"coupon_code\" or \"group\" is required")
+
+	_, _, err = coupons.Update(context.Background(), &UpdateCouponOptions{CouponCode: "SPRING", Group: "spring"})
+	errorred(t, err, "only one of \"coupon_code\" and \"group\" can be specified")
+}
+
+func TestCouponsUpdateOnSuccess(t *testing.T) {
+	var form url.Values
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		ok(t, req.ParseForm())
+		form = req.PostForm
+
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBuffer([]byte(couponsUpdateJSON))),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	u, _ := url.Parse(sandboxBaseURL)
+	coupons := Coupons{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}}
+
+	result, _, err := coupons.Update(context.Background(), &UpdateCouponOptions{Group: "spring", AllowedUses: 5, Recurring: Bool(false)})
+	ok(t, err)
+
+	equals(t, &UpdateCouponResponse{Updated: 2}, result)
+	equals(t, "spring", form.Get("group"))
+	equals(t, "5", form.Get("allowed_uses"))
+	equals(t, "0", form.Get("recurring"))
+}
+
+func TestCouponsDeleteOnAPIError(t *testing.T) {
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBuffer([]byte(usersCancelErrorJSON))),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	u, _ := url.Parse(sandboxBaseURL)
+	coupons := Coupons{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}}
+
+	_, err := coupons.Delete(context.Background(), &DeleteCouponOptions{CouponCode: "SPRING"})
+	equals(t, &APIError{102, "Bad api key"}, err)
+}
+
+func TestCouponsDeleteOnSuccess(t *testing.T) {
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBuffer([]byte(usersCancelJSON))),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	u, _ := url.Parse(sandboxBaseURL)
+	coupons := Coupons{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}}
+
+	_, err := coupons.Delete(context.Background(), &DeleteCouponOptions{CouponCode: "SPRING", ProductID: 12345})
+	ok(t, err)
+
+	_, err = coupons.Delete(context.Background(), &DeleteCouponOptions{})
+	errorred(t, err, "\"coupon_code\" is required")
+}
+
+const couponsListJSON = `{
+    "success": true,
+    "response": [
+        {
+            "coupon": "56604810",
+            "description": "Spring sale",
+            "discount_type": "percentage",
+            "discount_amount": 0.3,
+            "discount_currency": null,
+            "allowed_uses": 1,
+            "times_used": 0,
+            "is_recurring": false,
+            "expires": "2022-12-31 00:00:00"
+        },
+        {
+            "coupon": "FLAT10",
+            "description": null,
+            "discount_type": "flat",
+            "discount_amount": 10,
+            "discount_currency": "USD",
+            "allowed_uses": 100,
+            "times_used": 12,
+            "is_recurring": true,
+            "expires": null
+        }
+    ]
+}`
+
+const couponsCreateJSON = `{
+    "success": true,
+    "response": {
+        "coupon_codes": ["SPRING-0C5E7A3D", "SPRING-5D8B6E7F"]
+    }
+}`
+
+const couponsUpdateJSON = `{
+    "success": true,
+    "response": {
+        "updated": 2
+    }
+}`
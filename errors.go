@@ -0,0 +1,122 @@
+package paddle
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// maxErrorBodyLength limits the size of the response body kept in HTTPError.
+const maxErrorBodyLength = 512
+
+var (
+	// ErrNotFound is matched by errors when the requested resource (subscription, product, payment, etc.) does not exist.
+	ErrNotFound = errors.New("paddle: not found")
+	// ErrAuth is matched by errors when the vendor credentials are invalid or lack permissions.
+	ErrAuth = errors.New("paddle: authentication failed")
+	// ErrRateLimited is matched by errors when Paddle throttles requests.
+	ErrRateLimited = errors.New("paddle: rate limited")
+	// ErrValidation is matched by errors when the request arguments are rejected by Paddle.
+	ErrValidation = errors.New("paddle: validation failed")
+)
+
+// apiErrorCodes maps the documented Paddle API error codes to the error categories.
+var apiErrorCodes = map[int]error{
+	100: ErrNotFound,   // unable to find requested license
+	101: ErrValidation, // bad method call
+	102: ErrAuth,       // bad api key
+	103: ErrAuth,       // timestamp is too old or not valid
+	106: ErrNotFound,   // unable to find requested activation
+	107: ErrAuth,       // you don't have permission to access this resource
+	108: ErrNotFound,   // unable to find requested product
+	109: ErrValidation, // provided currency is not valid
+	110: ErrNotFound,   // unable to find requested purchase
+	111: ErrAuth,       // invalid authentication token
+	112: ErrAuth,       // invalid verification token
+	114: ErrValidation, // invalid or duplicated affiliate
+	115: ErrValidation, // invalid or missing affiliate commission
+	116: ErrValidation, // one or more required arguments are missing
+	117: ErrValidation, // provided expiration time is incorrect
+	118: ErrValidation, // the price is too low
+	119: ErrNotFound,   // unable to find requested subscription
+	121: ErrNotFound,   // unable to find requested payment
+	122: ErrValidation, // provided date is not valid
+	123: ErrNotFound,   // unable to find requested modifier
+}
+
+// Is reports whether the API error belongs to the target error category, e.g. errors.Is(err, ErrNotFound).
+func (e *APIError) Is(target error) bool {
+	category, ok := apiErrorCodes[e.Code]
+
+	return ok && category == target
+}
+
+// HTTPError represents an unsuccessful HTTP response that can't be decoded as a Paddle API response,
+// e.g. an HTML error page from a proxy.
+type HTTPError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// Body is the response body truncated to 512 bytes.
+	Body string
+	// Err is the underlying cause, if any.
+	Err error
+}
+
+// newHTTPError instantiates a new HTTP error with the truncated response body.
+func newHTTPError(statusCode int, body []byte, err error) *HTTPError {
+	if len(body) > maxErrorBodyLength {
+		body = body[:maxErrorBodyLength]
+	}
+
+	return &HTTPError{StatusCode: statusCode, Body: string(body), Err: err}
+}
+
+// Error formats the error as a string.
+func (e *HTTPError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("Paddle HTTP error: status=%d, body=%q: %s", e.StatusCode, e.Body, e.Err)
+	}
+
+	return fmt.Sprintf("Paddle HTTP error: status=%d, body=%q", e.StatusCode, e.Body)
+}
+
+// Unwrap returns the underlying cause.
+func (e *HTTPError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether the HTTP error belongs to the target error category, e.g. errors.Is(err, ErrRateLimited).
+func (e *HTTPError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrAuth:
+		return e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrValidation:
+		return e.StatusCode == http.StatusBadRequest || e.StatusCode == http.StatusUnprocessableEntity
+	}
+
+	return false
+}
+
+// IsNotFound reports whether the error means that the requested resource does not exist.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// IsAuthError reports whether the error means that the vendor credentials are invalid or lack permissions.
+func IsAuthError(err error) bool {
+	return errors.Is(err, ErrAuth)
+}
+
+// IsRateLimited reports whether the error means that Paddle throttled the request.
+func IsRateLimited(err error) bool {
+	return errors.Is(err, ErrRateLimited)
+}
+
+// IsValidationError reports whether the error means that the request arguments were rejected.
+func IsValidationError(err error) bool {
+	return errors.Is(err, ErrValidation)
+}
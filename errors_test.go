@@ -0,0 +1,55 @@
+package paddle
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestAPIErrorCategories(t *testing.T) {
+	equals(t, true, IsAuthError(&APIError{102, "Bad api key"}))
+	equals(t, true, IsNotFound(fmt.Errorf("wrapped: %w", &APIError{119, "Unable to find requested subscription"})))
+	equals(t, true, IsValidationError(&APIError{116, "One or more required arguments are missing"}))
+	equals(t, false, IsNotFound(&APIError{102, "Bad api key"}))
+	equals(t, false, IsRateLimited(&APIError{120, "Internal error"}))
+
+	var apiError *APIError
+	equals(t, true, errors.As(fmt.Errorf("wrapped: %w", &APIError{102, "Bad api key"}), &apiError))
+	equals(t, 102, apiError.Code)
+}
+
+func TestHTTPErrorCategories(t *testing.T) {
+	equals(t, true, IsRateLimited(&HTTPError{StatusCode: http.StatusTooManyRequests}))
+	equals(t, true, IsAuthError(&HTTPError{StatusCode: http.StatusForbidden}))
+	equals(t, true, IsNotFound(&HTTPError{StatusCode: http.StatusNotFound}))
+	equals(t, true, IsValidationError(&HTTPError{StatusCode: http.StatusBadRequest}))
+	equals(t, false, IsNotFound(&HTTPError{StatusCode: http.StatusBadGateway}))
+}
+
+func TestHTTPErrorTruncatesBody(t *testing.T) {
+	body := strings.Repeat("a", 2*maxErrorBodyLength)
+	expectedResponse := &http.Response{
+		StatusCode: 200,
+		Body:       ioutil.NopCloser(bytes.NewBuffer([]byte(body))),
+		Header:     make(http.Header),
+	}
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		return expectedResponse, nil
+	})
+
+	u, _ := url.Parse(sandboxBaseURL)
+	users := Users{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}}
+
+	_, err := users.Cancel(context.Background(), &CancelUserOptions{42})
+
+	var httpError *HTTPError
+	equals(t, true, errors.As(err, &httpError))
+	equals(t, maxErrorBodyLength, len(httpError.Body))
+	errorred(t, err, "failed to unmarshal JSON")
+}
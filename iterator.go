@@ -0,0 +1,174 @@
+package paddle
+
+import (
+	"context"
+)
+
+// Iterator walks paginated API results page by page until an empty page is returned.
+//
+//	iterator := client.Users.Iter(ctx, options)
+//	defer iterator.Close()
+//	for iterator.Next() {
+//		user := iterator.Value()
+//		// ...
+//	}
+//	if err := iterator.Err(); err != nil {
+//		// ...
+//	}
+type Iterator[T any] struct {
+	ctx      context.Context
+	cancel   context.CancelFunc
+	fetch    func(ctx context.Context, page int) ([]T, error)
+	prefetch bool
+
+	page    int
+	items   []T
+	index   int
+	current T
+	pending chan pageResult[T]
+	err     error
+	done    bool
+}
+
+// pageResult represents a fetched page.
+type pageResult[T any] struct {
+	items []T
+	err   error
+}
+
+// iteratorOptions represents settings of the iterator.
+type iteratorOptions struct {
+	prefetch bool
+}
+
+// IteratorOption configures the iterator.
+type IteratorOption func(*iteratorOptions)
+
+// WithPrefetch makes the iterator fetch the next page concurrently while the current one is processed.
+func WithPrefetch() IteratorOption {
+	return func(options *iteratorOptions) {
+		options.prefetch = true
+	}
+}
+
+// newIterator instantiates a new iterator starting with the specified page.
+func newIterator[T any](ctx context.Context, firstPage int, fetch func(ctx context.Context, page int) ([]T, error), options []IteratorOption) *Iterator[T] {
+	settings := &iteratorOptions{}
+	for _, option := range options {
+		option(settings)
+	}
+
+	if firstPage < 1 {
+		firstPage = 1
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	return &Iterator[T]{
+		ctx:      ctx,
+		cancel:   cancel,
+		fetch:    fetch,
+		prefetch: settings.prefetch,
+		page:     firstPage,
+	}
+}
+
+// Next advances the iterator to the next value, it returns false when there are no more values
+// or an error occurred.
+func (it *Iterator[T]) Next() bool {
+	if it.done {
+		return false
+	}
+
+	if err := it.ctx.Err(); err != nil {
+		it.stop(err)
+		return false
+	}
+
+	if it.index >= len(it.items) {
+		items, err := it.loadPage()
+		if err != nil {
+			it.stop(err)
+			return false
+		}
+		if len(items) == 0 {
+			it.stop(nil)
+			return false
+		}
+
+		it.items = items
+		it.index = 0
+	}
+
+	it.current = it.items[it.index]
+	it.index++
+
+	return true
+}
+
+// Value returns the current value.
+func (it *Iterator[T]) Value() T {
+	return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// Close stops the iteration and cancels the prefetching, it is safe to call it multiple times.
+func (it *Iterator[T]) Close() {
+	it.stop(nil)
+}
+
+// stop finishes the iteration with the specified error.
+func (it *Iterator[T]) stop(err error) {
+	if !it.done {
+		it.done = true
+		it.err = err
+	}
+	it.cancel()
+}
+
+// loadPage returns the current page, prefetched or not, and starts prefetching the next one if enabled.
+func (it *Iterator[T]) loadPage() ([]T, error) {
+	var result pageResult[T]
+	if it.pending != nil {
+		select {
+		case result = <-it.pending:
+		case <-it.ctx.Done():
+			return nil, it.ctx.Err()
+		}
+		it.pending = nil
+	} else {
+		result.items, result.err = it.fetch(it.ctx, it.page)
+	}
+
+	if result.err != nil {
+		return nil, result.err
+	}
+
+	it.page++
+	if it.prefetch && len(result.items) > 0 {
+		pending := make(chan pageResult[T], 1)
+		go func(page int) {
+			items, err := it.fetch(it.ctx, page)
+			pending <- pageResult[T]{items: items, err: err}
+		}(it.page)
+		it.pending = pending
+	}
+
+	return result.items, nil
+}
+
+// collect reads all values from the iterator.
+func collect[T any](it *Iterator[T]) ([]T, error) {
+	defer it.Close()
+
+	var values []T
+	for it.Next() {
+		values = append(values, it.Value())
+	}
+
+	return values, it.Err()
+}
@@ -0,0 +1,71 @@
+package paddle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Licenses is an API to work with the Paddle licenses of desktop products.
+type Licenses api
+
+// GenerateLicenseOptions represents options for generating a license.
+type GenerateLicenseOptions struct {
+	ProductID uint64
+	// AllowedUses is the number of times the license can be activated.
+	AllowedUses int
+	// ExpiresAt is the expiration date of the license, the license never expires if it is zero.
+	ExpiresAt time.Time
+}
+
+// encodeURLValues encodes options as URL parameters.
+func (options *GenerateLicenseOptions) encodeURLValues() (url.Values, error) {
+	values := make(url.Values)
+	if options.ProductID == 0 {
+		return nil, errors.New("\"product_id\" is required")
+	}
+	if options.AllowedUses <= 0 {
+		return nil, errors.New("\"allowed_uses\" must be positive")
+	}
+
+	values.Set("product_id", strconv.FormatUint(options.ProductID, 10))
+	values.Set("allowed_uses", strconv.Itoa(options.AllowedUses))
+	if !options.ExpiresAt.IsZero() {
+		values.Set("expires_at", options.ExpiresAt.Format("2006-01-02"))
+	}
+
+	return values, nil
+}
+
+// GenerateLicenseResponse represents a response for the generate license request.
+type GenerateLicenseResponse struct {
+	LicenseCode string `json:"license_code,omitempty"`
+	ExpiresAt   string `json:"expires_at,omitempty"`
+}
+
+// Generate generates a new license for the product.
+//
+// Paddle docs: https://developer.paddle.com/api-reference/product-api/licenses/createlicense
+func (licenses *Licenses) Generate(ctx context.Context, options *GenerateLicenseOptions) (*GenerateLicenseResponse, *http.Response, error) {
+	path := "2.0/product/generate_license"
+
+	if options == nil {
+		options = new(GenerateLicenseOptions)
+	}
+	request, err := newRequest(ctx, http.MethodPost, licenses.baseURL, path, licenses.authentication, options)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create new request: %w", err)
+	}
+
+	response := new(response[*GenerateLicenseResponse])
+	httpResponse, err := doNonIdempotentRequest((*api)(licenses), request, response)
+	if err != nil {
+		return nil, httpResponse, err
+	}
+
+	return response.value(), httpResponse, nil
+}
@@ -0,0 +1,86 @@
+package paddle
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestLicensesGenerateOnValidationError(t *testing.T) {
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		return nil, nil
+	})
+	u, _ := url.Parse(sandboxBaseURL)
+	licenses := Licenses{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}}
+
+	_, _, err := licenses.Generate(context.Background(), nil)
+	errorred(t, err, "\"product_id\" is required")
+
+	_, _, err = licenses.Generate(context.Background(), &GenerateLicenseOptions{ProductID: 12345})
+	errorred(t, err, "\"allowed_uses\" must be positive")
+}
+
+func TestLicensesGenerateOnAPIError(t *testing.T) {
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBuffer([]byte(licensesGenerateErrorJSON))),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	u, _ := url.Parse(sandboxBaseURL)
+	licenses := Licenses{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}}
+
+	_, _, err := licenses.Generate(context.Background(), &GenerateLicenseOptions{ProductID: 12345, AllowedUses: 1})
+	equals(t, &APIError{108, "Unable to find requested product"}, err)
+}
+
+func TestLicensesGenerateOnSuccess(t *testing.T) {
+	var form url.Values
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		ok(t, req.ParseForm())
+		form = req.PostForm
+
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBuffer([]byte(licensesGenerateJSON))),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	u, _ := url.Parse(sandboxBaseURL)
+	licenses := Licenses{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}}
+
+	result, _, err := licenses.Generate(context.Background(), &GenerateLicenseOptions{
+		ProductID:   12345,
+		AllowedUses: 3,
+		ExpiresAt:   time.Date(2023, 10, 10, 0, 0, 0, 0, time.UTC),
+	})
+	ok(t, err)
+
+	equals(t, &GenerateLicenseResponse{LicenseCode: "D6A1B3E6-1B5C3A1F-8A8D0A44-2E3B4F1A-4D3C2B1A", ExpiresAt: "2023-10-10"}, result)
+	equals(t, "12345", form.Get("product_id"))
+	equals(t, "3", form.Get("allowed_uses"))
+	equals(t, "2023-10-10", form.Get("expires_at"))
+}
+
+const licensesGenerateJSON = `{
+    "success": true,
+    "response": {
+        "license_code": "D6A1B3E6-1B5C3A1F-8A8D0A44-2E3B4F1A-4D3C2B1A",
+        "expires_at": "2023-10-10"
+    }
+}`
+
+const licensesGenerateErrorJSON = `{
+    "success": false,
+    "error": {
+        "code": 108,
+        "message": "Unable to find requested product"
+    }
+}`
@@ -59,10 +59,96 @@ func (modifiers *Modifiers) Create(ctx context.Context, options *CreateModifierO
 	}
 
 	response := new(response[*CreateModifierResponse])
-	httpResponse, err := doRequest(modifiers.httpClient, request, response)
+	httpResponse, err := doNonIdempotentRequest((*api)(modifiers), request, response)
 	if err != nil {
 		return nil, httpResponse, err
 	}
 
-	return *response.Response, httpResponse, nil
+	return response.value(), httpResponse, nil
+}
+
+// Modifier represents a subscription modifier.
+type Modifier struct {
+	ID             uint64 `json:"modifier_id,omitempty"`
+	SubscriptionID uint64 `json:"subscription_id,omitempty"`
+	Amount         string `json:"amount,omitempty"`
+	Currency       string `json:"currency,omitempty"`
+	IsRecurring    bool   `json:"is_recurring,omitempty"`
+	Description    string `json:"description,omitempty"`
+}
+
+// listModifiersOptions represents options for listing modifiers.
+type listModifiersOptions struct {
+	subscriptionID uint64
+	planID         uint64
+}
+
+// encodeURLValues encodes options as URL parameters.
+func (options *listModifiersOptions) encodeURLValues() (url.Values, error) {
+	values := make(url.Values)
+	if options.subscriptionID != 0 {
+		values.Set("subscription_id", strconv.FormatUint(options.subscriptionID, 10))
+	}
+	if options.planID != 0 {
+		values.Set("plan_id", strconv.FormatUint(options.planID, 10))
+	}
+
+	return values, nil
+}
+
+// List returns modifiers of all subscriptions, zero subscriptionID or planID are not used for filtering.
+//
+// Paddle docs: https://developer.paddle.com/api-reference/subscription-api/modifiers/listmodifiers
+func (modifiers *Modifiers) List(ctx context.Context, subscriptionID uint64, planID uint64) ([]*Modifier, *http.Response, error) {
+	path := "2.0/subscription/modifiers"
+
+	options := &listModifiersOptions{subscriptionID: subscriptionID, planID: planID}
+	request, err := newRequest(ctx, http.MethodPost, modifiers.baseURL, path, modifiers.authentication, options)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create new request: %w", err)
+	}
+
+	response := new(response[[]*Modifier])
+	httpResponse, err := doRequest((*api)(modifiers), request, response)
+	if err != nil {
+		return nil, httpResponse, err
+	}
+
+	return response.value(), httpResponse, nil
+}
+
+// deleteModifierOptions represents options for deleting a modifier.
+type deleteModifierOptions struct {
+	modifierID uint64
+}
+
+// encodeURLValues encodes options as URL parameters.
+func (options *deleteModifierOptions) encodeURLValues() (url.Values, error) {
+	values := make(url.Values)
+	if options.modifierID == 0 {
+		return nil, errors.New("\"modifier_id\" is required")
+	}
+
+	values.Set("modifier_id", strconv.FormatUint(options.modifierID, 10))
+
+	return values, nil
+}
+
+// Delete deletes the modifier.
+//
+// Paddle docs: https://developer.paddle.com/api-reference/subscription-api/modifiers/deletemodifier
+func (modifiers *Modifiers) Delete(ctx context.Context, modifierID uint64) (*http.Response, error) {
+	path := "2.0/subscription/modifiers/delete"
+
+	request, err := newRequest(ctx, http.MethodPost, modifiers.baseURL, path, modifiers.authentication, &deleteModifierOptions{modifierID: modifierID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create new request: %w", err)
+	}
+
+	httpResponse, err := doRequest((*api)(modifiers), request, new(response[interface{}]))
+	if err != nil {
+		return httpResponse, err
+	}
+
+	return httpResponse, nil
 }
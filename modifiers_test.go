@@ -0,0 +1,107 @@
+package paddle
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestModifiersListOnSuccess(t *testing.T) {
+	var form url.Values
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		ok(t, req.ParseForm())
+		form = req.PostForm
+
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBuffer([]byte(modifiersListJSON))),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	u, _ := url.Parse(sandboxBaseURL)
+	modifiers := Modifiers{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}}
+
+	result, _, err := modifiers.List(context.Background(), 12345, 0)
+	ok(t, err)
+
+	equals(t, "12345", form.Get("subscription_id"))
+	equals(t, false, form.Has("plan_id"))
+	equals(t, []*Modifier{
+		{ID: 10, SubscriptionID: 12345, Amount: "1.000", Currency: "USD", IsRecurring: false, Description: "Example Modifier"},
+		{ID: 11, SubscriptionID: 12345, Amount: "-5.000", Currency: "USD", IsRecurring: true, Description: "Loyalty discount"},
+	}, result)
+}
+
+func TestModifiersListOnAPIError(t *testing.T) {
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBuffer([]byte(usersListErrorJSON))),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	u, _ := url.Parse(sandboxBaseURL)
+	modifiers := Modifiers{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}}
+
+	_, _, err := modifiers.List(context.Background(), 0, 0)
+	equals(t, &APIError{102, "Bad api key"}, err)
+}
+
+func TestModifiersDeleteOnValidationError(t *testing.T) {
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		return nil, nil
+	})
+	u, _ := url.Parse(sandboxBaseURL)
+	modifiers := Modifiers{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}}
+
+	_, err := modifiers.Delete(context.Background(), 0)
+	errorred(t, err, "\"modifier_id\" is required")
+}
+
+func TestModifiersDeleteOnSuccess(t *testing.T) {
+	var form url.Values
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		ok(t, req.ParseForm())
+		form = req.PostForm
+
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBuffer([]byte(usersCancelJSON))),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	u, _ := url.Parse(sandboxBaseURL)
+	modifiers := Modifiers{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}}
+
+	_, err := modifiers.Delete(context.Background(), 10)
+	ok(t, err)
+	equals(t, "10", form.Get("modifier_id"))
+}
+
+const modifiersListJSON = `{
+    "success": true,
+    "response": [
+        {
+            "modifier_id": 10,
+            "subscription_id": 12345,
+            "amount": "1.000",
+            "currency": "USD",
+            "is_recurring": false,
+            "description": "Example Modifier"
+        },
+        {
+            "modifier_id": 11,
+            "subscription_id": 12345,
+            "amount": "-5.000",
+            "currency": "USD",
+            "is_recurring": true,
+            "description": "Loyalty discount"
+        }
+    ]
+}`
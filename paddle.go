@@ -3,10 +3,13 @@ package paddle
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 )
@@ -15,8 +18,13 @@ const (
 	productionBaseURL = "https://vendors.paddle.com/api/"
 	sandboxBaseURL    = "https://sandbox-vendors.paddle.com/api/"
 
+	productionCheckoutBaseURL = "https://checkout.paddle.com/api/"
+	sandboxCheckoutBaseURL    = "https://sandbox-checkout.paddle.com/api/"
+
 	vendorID       = "vendor_id"
 	vendorAuthCode = "vendor_auth_code"
+
+	defaultUserAgent = "github.com/krasun/paddle"
 )
 
 // api is type shared by different Paddle API sections implementations, like
@@ -26,6 +34,10 @@ type api struct {
 	authentication *Authentication
 	baseURL        *url.URL
 	httpClient     *http.Client
+	userAgent      string
+	retryPolicy    *RetryPolicy
+	rateLimiter    *RateLimiter
+	apiKey         string
 }
 
 // Client is a Paddle client.
@@ -36,6 +48,28 @@ type Client struct {
 	Modifiers *Modifiers
 	// Charges represents an API for working with subscription charges.
 	Charges *Charges
+	// Products represents an API for working with products and pay links.
+	Products *Products
+	// Coupons represents an API for working with coupons.
+	Coupons *Coupons
+	// Plans represents an API for working with subscription plans.
+	Plans *Plans
+	// Payments represents an API for working with subscription payments.
+	Payments *Payments
+	// Refunds represents an API for refunding payments.
+	Refunds *Refunds
+	// Transactions represents an API for working with transactions.
+	Transactions *Transactions
+	// WebhookHistory represents an API for working with the history of sent webhook alerts.
+	WebhookHistory *WebhookHistory
+	// Licenses represents an API for generating licenses of desktop products.
+	Licenses *Licenses
+	// UserHistory represents an API for requesting the user history.
+	UserHistory *UserHistory
+	// Orders represents the checkout API for getting order information.
+	Orders *Orders
+	// Prices represents the checkout API for getting localized product prices.
+	Prices *Prices
 }
 
 // Authentication represents credentials for working with the Paddle API.
@@ -47,37 +81,201 @@ type Authentication struct {
 	VendorAuthCode string
 }
 
-// NewProductionClient creates a new Paddle production client.
-func NewProductionClient(authentication Authentication) (*Client, error) {
-	baseURL, err := url.Parse(productionBaseURL)
+// Environment represents a Paddle environment: production or sandbox.
+type Environment string
+
+const (
+	// EnvironmentProduction represents the Paddle production environment.
+	EnvironmentProduction Environment = "production"
+	// EnvironmentSandbox represents the Paddle sandbox environment.
+	EnvironmentSandbox Environment = "sandbox"
+)
+
+// clientOptions represents settings used to instantiate a new Paddle client.
+type clientOptions struct {
+	environment     Environment
+	baseURL         string
+	checkoutBaseURL string
+	httpClient      *http.Client
+	userAgent       string
+	retryPolicy     *RetryPolicy
+	rateLimiter     *RateLimiter
+}
+
+// Option configures the Paddle client.
+type Option func(*clientOptions) error
+
+// WithHTTPClient sets the HTTP client used for all API requests,
+// it allows to configure timeouts, proxies, transports, etc.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(options *clientOptions) error {
+		if httpClient == nil {
+			return errors.New("HTTP client can't be nil")
+		}
+		options.httpClient = httpClient
+
+		return nil
+	}
+}
+
+// WithBaseURL overrides the base URL of the vendor API, or the Billing API for the BillingClient,
+// e.g. to point the client to a local stand-in.
+func WithBaseURL(baseURL string) Option {
+	return func(options *clientOptions) error {
+		if baseURL == "" {
+			return errors.New("base URL can't be empty")
+		}
+		options.baseURL = baseURL
+
+		return nil
+	}
+}
+
+// WithCheckoutBaseURL overrides the base URL of the checkout API used by Orders, Prices and UserHistory,
+// the BillingClient rejects it.
+func WithCheckoutBaseURL(baseURL string) Option {
+	return func(options *clientOptions) error {
+		if baseURL == "" {
+			return errors.New("checkout base URL can't be empty")
+		}
+		options.checkoutBaseURL = baseURL
+
+		return nil
+	}
+}
+
+// WithUserAgent sets the "User-Agent" header sent with every API request.
+func WithUserAgent(userAgent string) Option {
+	return func(options *clientOptions) error {
+		options.userAgent = userAgent
+
+		return nil
+	}
+}
+
+// WithEnvironment sets the Paddle environment, production is used by default.
+func WithEnvironment(environment Environment) Option {
+	return func(options *clientOptions) error {
+		switch environment {
+		case EnvironmentProduction, EnvironmentSandbox:
+			options.environment = environment
+		default:
+			return fmt.Errorf("unsupported environment: %s", environment)
+		}
+
+		return nil
+	}
+}
+
+// NewClient creates a new Paddle client configured with the specified options.
+func NewClient(authentication Authentication, options ...Option) (*Client, error) {
+	settings, err := newClientOptions(options)
+	if err != nil {
+		return nil, err
+	}
+
+	baseURL, err := parseBaseURL(settings.baseURL, settings.environment, productionBaseURL, sandboxBaseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	checkoutBaseURL, err := parseBaseURL(settings.checkoutBaseURL, settings.environment, productionCheckoutBaseURL, sandboxCheckoutBaseURL)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse base URL %s: %w", productionBaseURL, err)
+		return nil, err
 	}
 
-	return newClient(&authentication, baseURL), nil
+	vendor := &api{
+		authentication: &authentication,
+		baseURL:        baseURL,
+		httpClient:     settings.httpClient,
+		userAgent:      settings.userAgent,
+		retryPolicy:    settings.retryPolicy,
+		rateLimiter:    settings.rateLimiter,
+	}
+
+	// the checkout API is public and does not need the vendor credentials
+	checkout := *vendor
+	checkout.authentication = nil
+	checkout.baseURL = checkoutBaseURL
+
+	return newClient(vendor, &checkout), nil
 }
 
-// NewSandboxClient creates a new Paddle sandbox client.
-func NewSandboxClient(authentication Authentication) (*Client, error) {
-	baseURL, err := url.Parse(sandboxBaseURL)
+// newClientOptions applies the options on top of the defaults.
+func newClientOptions(options []Option) (*clientOptions, error) {
+	settings := &clientOptions{
+		environment: EnvironmentProduction,
+		httpClient:  http.DefaultClient,
+		userAgent:   defaultUserAgent,
+	}
+	for _, option := range options {
+		if err := option(settings); err != nil {
+			return nil, fmt.Errorf("failed to apply option: %w", err)
+		}
+	}
+
+	return settings, nil
+}
+
+// parseBaseURL parses the overridden base URL or picks the default one for the environment.
+func parseBaseURL(rawBaseURL string, environment Environment, production string, sandbox string) (*url.URL, error) {
+	if rawBaseURL == "" {
+		rawBaseURL = production
+		if environment == EnvironmentSandbox {
+			rawBaseURL = sandbox
+		}
+	}
+
+	baseURL, err := url.Parse(rawBaseURL)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse base URL %s: %w", sandboxBaseURL, err)
+		return nil, fmt.Errorf("failed to parse base URL %s: %w", rawBaseURL, err)
 	}
 
-	return newClient(&authentication, baseURL), nil
+	return baseURL, nil
 }
 
-// newClient instantiates a new Paddle client with the specified base URL.
-func newClient(authentication *Authentication, baseURL *url.URL) *Client {
-	httpClient := http.DefaultClient
+// NewProductionClient creates a new Paddle production client.
+func NewProductionClient(authentication Authentication) (*Client, error) {
+	return NewClient(authentication, WithEnvironment(EnvironmentProduction))
+}
+
+// NewSandboxClient creates a new Paddle sandbox client.
+func NewSandboxClient(authentication Authentication) (*Client, error) {
+	return NewClient(authentication, WithEnvironment(EnvironmentSandbox))
+}
+
+// newClient instantiates a new Paddle client, every vendor API section gets its own copy of the vendor settings,
+// checkout API sections get their own copies of the checkout settings.
+func newClient(vendor *api, checkout *api) *Client {
+	// the user history is a checkout API endpoint, it needs only the vendor ID, not the auth code
+	userHistory := checkout.copy()
+	userHistory.authentication = vendor.authentication
 
 	return &Client{
-		&Users{httpClient: httpClient, baseURL: baseURL, authentication: authentication},
-		&Modifiers{httpClient: httpClient, baseURL: baseURL, authentication: authentication},
-		&Charges{httpClient: httpClient, baseURL: baseURL, authentication: authentication},
+		Users:          (*Users)(vendor.copy()),
+		Modifiers:      (*Modifiers)(vendor.copy()),
+		Charges:        (*Charges)(vendor.copy()),
+		Products:       (*Products)(vendor.copy()),
+		Coupons:        (*Coupons)(vendor.copy()),
+		Plans:          (*Plans)(vendor.copy()),
+		Payments:       (*Payments)(vendor.copy()),
+		Refunds:        (*Refunds)(vendor.copy()),
+		Transactions:   (*Transactions)(vendor.copy()),
+		WebhookHistory: (*WebhookHistory)(vendor.copy()),
+		Licenses:       (*Licenses)(vendor.copy()),
+		UserHistory:    (*UserHistory)(userHistory),
+		Orders:         (*Orders)(checkout.copy()),
+		Prices:         (*Prices)(checkout.copy()),
 	}
 }
 
+// copy returns a shallow copy of the API settings, so changing the settings of one section
+// does not affect the other sections. The HTTP client, the retry policy and the rate limiter are still shared.
+func (a *api) copy() *api {
+	copied := *a
+	return &copied
+}
+
 // prepareURL copies base URL with a new path parameter.
 func prepareURL(baseURL *url.URL, path string) (*url.URL, error) {
 	u, err := baseURL.Parse(path)
@@ -109,6 +307,16 @@ func newRequest(ctx context.Context, method string, baseURL *url.URL, path strin
 		values.Set(vendorAuthCode, authentication.VendorAuthCode)
 	}
 
+	if method == http.MethodGet {
+		u.RawQuery = values.Encode()
+		request, err := http.NewRequestWithContext(ctx, method, u.String(), nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to instantiate new request: %w", err)
+		}
+
+		return request, nil
+	}
+
 	body := strings.NewReader(values.Encode())
 	request, err := http.NewRequestWithContext(ctx, method, u.String(), body)
 	if err != nil {
@@ -122,11 +330,84 @@ func newRequest(ctx context.Context, method string, baseURL *url.URL, path strin
 	return request, nil
 }
 
+// Bool returns a pointer to the bool value, it is used to set optional boolean options explicitly.
+func Bool(value bool) *bool {
+	return &value
+}
+
+// String returns a pointer to the string value, it is used to set optional string options explicitly.
+func String(value string) *string {
+	return &value
+}
+
 // urlValuesEncoder encodes URL values.
 type urlValuesEncoder interface {
 	encodeURLValues() (url.Values, error)
 }
 
+// emptyOptions represents options of the requests without parameters.
+type emptyOptions struct{}
+
+// encodeURLValues encodes no URL parameters.
+func (options *emptyOptions) encodeURLValues() (url.Values, error) {
+	return make(url.Values), nil
+}
+
+// setString sets the URL parameter if the value is not empty.
+func setString(values url.Values, key string, value string) {
+	if value != "" {
+		values.Set(key, value)
+	}
+}
+
+// setBool sets the URL parameter if the value is set explicitly.
+func setBool(values url.Values, key string, value *bool) {
+	if value != nil {
+		values.Set(key, strconv.FormatBool(*value))
+	}
+}
+
+// setPrices sets prices as an indexed array of "CURRENCY:amount" URL parameters ordered by currency.
+func setPrices(values url.Values, key string, prices map[string]string) {
+	currencies := make([]string, 0, len(prices))
+	for currency := range prices {
+		currencies = append(currencies, currency)
+	}
+	sort.Strings(currencies)
+
+	for i, currency := range currencies {
+		values.Set(fmt.Sprintf("%s[%d]", key, i), currency+":"+prices[currency])
+	}
+}
+
+// setProductIDs sets product IDs as a comma-separated URL parameter.
+func setProductIDs(values url.Values, productIDs []uint64) {
+	if len(productIDs) == 0 {
+		return
+	}
+
+	ids := make([]string, len(productIDs))
+	for i, id := range productIDs {
+		ids[i] = strconv.FormatUint(id, 10)
+	}
+	values.Set("product_ids", strings.Join(ids, ","))
+}
+
+// amountPattern matches positive decimal amounts like "10" or "10.99".
+var amountPattern = regexp.MustCompile(`^[0-9]+(\.[0-9]+)?$`)
+
+// validateAmount checks that the amount is a positive decimal number.
+func validateAmount(amount string) error {
+	if !amountPattern.MatchString(amount) {
+		return errors.New("must be a decimal number")
+	}
+	if value, err := strconv.ParseFloat(amount, 64); err != nil || value <= 0 {
+		return errors.New("must be positive")
+	}
+
+	return nil
+}
+
 // APIError represents a Paddle API error.
 type APIError struct {
 	Code    int    `json:"code"`
@@ -138,34 +419,140 @@ func (e *APIError) Error() string {
 	return fmt.Sprintf("Paddle API error: code=%d, message=%s", e.Code, e.Message)
 }
 
+// responseDecoder decodes the body of the HTTP response.
+type responseDecoder interface {
+	// decode decodes the body and returns an error if the response is unsuccessful.
+	decode(httpResponse *http.Response, data []byte) error
+}
+
 // response represents a deserialized response from the Paddle API.
 type response[T any] struct {
-	Success  bool      `json:"bool"`
+	Success  bool      `json:"success"`
 	Error    *APIError `json:"error"`
 	Response *T        `json:"response"`
 }
 
-// doRequest executes an HTTP request, decodes response and returns both decoded and HTTP responses.
-func doRequest[T any](httpClient *http.Client, request *http.Request, paddleResponse *response[T]) (*http.Response, error) {
-	response, err := httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("failed to execute the request: %w", err)
+// value returns the decoded response or the zero value if the response is missing.
+func (r *response[T]) value() T {
+	if r.Response == nil {
+		var zero T
+		return zero
 	}
-	defer response.Body.Close()
 
-	data, err := ioutil.ReadAll(response.Body)
+	return *r.Response
+}
+
+// decode decodes the Paddle API response wrapped with "success", "error" and "response" fields.
+func (r *response[T]) decode(httpResponse *http.Response, data []byte) error {
+	*r = response[T]{}
+	successful := httpResponse.StatusCode >= 200 && httpResponse.StatusCode < 300
+
+	err := json.Unmarshal(data, r)
 	if err != nil {
-		return response, fmt.Errorf("failed to read response body: %w", err)
+		if !successful {
+			return newHTTPError(httpResponse.StatusCode, data, nil)
+		}
+
+		return newHTTPError(httpResponse.StatusCode, data, fmt.Errorf("failed to unmarshal JSON: %w", err))
+	}
+
+	if r.Error != nil {
+		return r.Error
 	}
 
-	err = json.Unmarshal(data, paddleResponse)
+	if !successful {
+		return newHTTPError(httpResponse.StatusCode, data, nil)
+	}
+
+	if !r.Success {
+		return newHTTPError(httpResponse.StatusCode, data, errors.New("\"success\" is false, but no error is provided"))
+	}
+
+	return nil
+}
+
+// rawResponse represents a response that is not wrapped with "success", "error" and "response" fields,
+// like the checkout API responses.
+type rawResponse[T any] struct {
+	Response T
+}
+
+// decode decodes the plain JSON response.
+func (r *rawResponse[T]) decode(httpResponse *http.Response, data []byte) error {
+	var zero T
+	r.Response = zero
+
+	if httpResponse.StatusCode < 200 || httpResponse.StatusCode >= 300 {
+		return newHTTPError(httpResponse.StatusCode, data, nil)
+	}
+
+	if err := json.Unmarshal(data, &r.Response); err != nil {
+		return newHTTPError(httpResponse.StatusCode, data, fmt.Errorf("failed to unmarshal JSON: %w", err))
+	}
+
+	return nil
+}
+
+// doRequest executes an idempotent HTTP request, retrying transient failures according to the retry policy,
+// decodes response and returns both decoded and HTTP responses.
+func doRequest(api *api, request *http.Request, paddleResponse responseDecoder) (*http.Response, error) {
+	return doRequestWithRetries(api, request, paddleResponse, true)
+}
+
+// doNonIdempotentRequest executes an HTTP request which can't be safely repeated,
+// it is retried only if the retry policy explicitly allows it.
+func doNonIdempotentRequest(api *api, request *http.Request, paddleResponse responseDecoder) (*http.Response, error) {
+	return doRequestWithRetries(api, request, paddleResponse, false)
+}
+
+// doRequestWithRetries executes the request until it succeeds, fails permanently or runs out of attempts.
+func doRequestWithRetries(api *api, request *http.Request, paddleResponse responseDecoder, idempotent bool) (*http.Response, error) {
+	policy := api.retryPolicy
+	if policy == nil || (!idempotent && !policy.RetryNonIdempotent) {
+		return doRequestOnce(api, request, paddleResponse)
+	}
+
+	attemptRequest := request
+	for attempt := 1; ; attempt++ {
+		httpResponse, err := doRequestOnce(api, attemptRequest, paddleResponse)
+		if attempt >= policy.MaxAttempts || !policy.retryable(httpResponse, err) {
+			return httpResponse, err
+		}
+
+		if sleepErr := sleep(request.Context(), policy.backoff(attempt, httpResponse)); sleepErr != nil {
+			return httpResponse, err
+		}
+
+		attemptRequest, err = retryRequest(request)
+		if err != nil {
+			return httpResponse, err
+		}
+	}
+}
+
+// doRequestOnce executes an HTTP request once, decodes response and returns both decoded and HTTP responses.
+func doRequestOnce(api *api, request *http.Request, paddleResponse responseDecoder) (*http.Response, error) {
+	if api.userAgent != "" {
+		request.Header.Set("User-Agent", api.userAgent)
+	}
+
+	if api.rateLimiter != nil {
+		endpoint := strings.TrimPrefix(request.URL.Path, api.baseURL.Path)
+		if err := api.rateLimiter.Wait(request.Context(), endpoint); err != nil {
+			return nil, fmt.Errorf("failed to wait for the rate limiter: %w", err)
+		}
+	}
+
+	response, err := api.httpClient.Do(request)
 	if err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON: %w", err)
+		return nil, fmt.Errorf("failed to execute the request: %w", err)
 	}
+	defer response.Body.Close()
 
-	if paddleResponse.Error != nil {
-		return response, paddleResponse.Error
+	data, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return response, fmt.Errorf("failed to read response body: %w", err)
 	}
 
-	return response, nil
+	return response, paddleResponse.decode(response, data)
 }
@@ -1,7 +1,13 @@
 package paddle
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
 	"path/filepath"
 	"reflect"
 	"runtime"
@@ -23,6 +29,129 @@ func TestUsersProductionURL(t *testing.T) {
 	equals(t, "https://vendors.paddle.com/api/", client.Users.baseURL.String())
 }
 
+func TestNewClientWithBaseURL(t *testing.T) {
+	client, err := NewClient(Authentication{VendorID: 42, VendorAuthCode: "abc"}, WithBaseURL("http://localhost:8080/api/"))
+	ok(t, err)
+
+	equals(t, "http://localhost:8080/api/", client.Users.baseURL.String())
+	equals(t, "http://localhost:8080/api/", client.Modifiers.baseURL.String())
+	equals(t, "http://localhost:8080/api/", client.Charges.baseURL.String())
+}
+
+func TestNewClientSectionsDoNotShareSettings(t *testing.T) {
+	client, err := NewClient(Authentication{VendorID: 42, VendorAuthCode: "abc"})
+	ok(t, err)
+
+	client.Users.userAgent = "users-agent/1.0"
+
+	equals(t, defaultUserAgent, client.Modifiers.userAgent)
+	equals(t, defaultUserAgent, client.Products.userAgent)
+}
+
+func TestNewClientWithEnvironment(t *testing.T) {
+	client, err := NewClient(Authentication{VendorID: 42, VendorAuthCode: "abc"}, WithEnvironment(EnvironmentSandbox))
+	ok(t, err)
+
+	equals(t, "https://sandbox-vendors.paddle.com/api/", client.Charges.baseURL.String())
+
+	_, err = NewClient(Authentication{VendorID: 42, VendorAuthCode: "abc"}, WithEnvironment("staging"))
+	errorred(t, err, "unsupported environment")
+}
+
+func TestNewClientWithHTTPClientAndUserAgent(t *testing.T) {
+	var userAgent string
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		userAgent = req.Header.Get("User-Agent")
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBuffer([]byte(usersCancelJSON))),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	client, err := NewClient(Authentication{VendorID: 42, VendorAuthCode: "abc"}, WithHTTPClient(httpClient), WithUserAgent("my-agent/1.0"))
+	ok(t, err)
+	equals(t, httpClient, client.Users.httpClient)
+
+	_, err = client.Users.Cancel(context.Background(), &CancelUserOptions{42})
+	ok(t, err)
+	equals(t, "my-agent/1.0", userAgent)
+
+	_, err = NewClient(Authentication{VendorID: 42, VendorAuthCode: "abc"}, WithHTTPClient(nil))
+	errorred(t, err, "HTTP client can't be nil")
+}
+
+func TestDoRequestOnHTMLErrorPage(t *testing.T) {
+	expectedResponse := &http.Response{
+		StatusCode: http.StatusBadGateway,
+		Body:       ioutil.NopCloser(bytes.NewBuffer([]byte("<html><body>502 Bad Gateway</body></html>"))),
+		Header:     make(http.Header),
+	}
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		return expectedResponse, nil
+	})
+
+	u, _ := url.Parse(sandboxBaseURL)
+	users := Users{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}}
+
+	actualResponse, err := users.Cancel(context.Background(), &CancelUserOptions{42})
+
+	equals(t, expectedResponse, actualResponse)
+	equals(t, &HTTPError{StatusCode: http.StatusBadGateway, Body: "<html><body>502 Bad Gateway</body></html>"}, err)
+}
+
+func TestDoRequestOnUnsuccessfulStatusCode(t *testing.T) {
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusServiceUnavailable,
+			Body:       ioutil.NopCloser(bytes.NewBuffer([]byte(usersCancelJSON))),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	u, _ := url.Parse(sandboxBaseURL)
+	users := Users{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}}
+
+	_, err := users.Cancel(context.Background(), &CancelUserOptions{42})
+
+	var httpError *HTTPError
+	equals(t, true, errors.As(err, &httpError))
+	equals(t, http.StatusServiceUnavailable, httpError.StatusCode)
+}
+
+func TestDoRequestOnUnsuccessfulResponseWithoutError(t *testing.T) {
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBuffer([]byte(`{"success": false}`))),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	u, _ := url.Parse(sandboxBaseURL)
+	users := Users{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}}
+
+	_, err := users.Cancel(context.Background(), &CancelUserOptions{42})
+	errorred(t, err, "\"success\" is false, but no error is provided")
+}
+
+func TestDoRequestDecodesSuccess(t *testing.T) {
+	paddleResponse := new(response[interface{}])
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBuffer([]byte(usersCancelJSON))),
+			Header:     make(http.Header),
+		}, nil
+	})
+	request, err := http.NewRequest(http.MethodPost, sandboxBaseURL, nil)
+	ok(t, err)
+
+	_, err = doRequest(&api{httpClient: httpClient}, request, paddleResponse)
+	ok(t, err)
+	equals(t, true, paddleResponse.Success)
+}
+
 // errorred fails the test if an err is nil or message is not found in the message string.
 func errorred(tb testing.TB, err error, message string) {
 	if err == nil {
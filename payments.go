@@ -0,0 +1,173 @@
+package paddle
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Payments is an API to work with the Paddle subscription payments.
+type Payments api
+
+// Payment represents a past or upcoming subscription payment.
+type Payment struct {
+	ID             uint64  `json:"id,omitempty"`
+	SubscriptionID uint64  `json:"subscription_id,omitempty"`
+	Amount         float64 `json:"amount,omitempty"`
+	Currency       string  `json:"currency,omitempty"`
+	PayoutDate     string  `json:"payout_date,omitempty"`
+	IsPaid         bool    `json:"is_paid,omitempty"`
+	IsOneOffCharge bool    `json:"is_one_off_charge,omitempty"`
+	ReceiptURL     string  `json:"receipt_url,omitempty"`
+}
+
+// UnmarshalJSON decodes the payment, Paddle encodes its flags either as booleans or as 0 and 1.
+func (payment *Payment) UnmarshalJSON(data []byte) error {
+	type plainPayment Payment
+	decoded := struct {
+		*plainPayment
+		IsPaid         json.RawMessage `json:"is_paid"`
+		IsOneOffCharge json.RawMessage `json:"is_one_off_charge"`
+	}{plainPayment: (*plainPayment)(payment)}
+
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	var err error
+	if payment.IsPaid, err = decodeFlag(decoded.IsPaid); err != nil {
+		return fmt.Errorf("failed to decode \"is_paid\": %w", err)
+	}
+	if payment.IsOneOffCharge, err = decodeFlag(decoded.IsOneOffCharge); err != nil {
+		return fmt.Errorf("failed to decode \"is_one_off_charge\": %w", err)
+	}
+
+	return nil
+}
+
+// decodeFlag decodes a boolean encoded as true/false or 1/0.
+func decodeFlag(data json.RawMessage) (bool, error) {
+	switch string(bytes.Trim(data, `"`)) {
+	case "", "null", "false", "0":
+		return false, nil
+	case "true", "1":
+		return true, nil
+	}
+
+	return false, fmt.Errorf("unexpected value %s", data)
+}
+
+// ListPaymentsOptions represents options for listing subscription payments.
+type ListPaymentsOptions struct {
+	SubscriptionID uint64
+	PlanID         uint64
+	// IsPaid filters paid or unpaid payments if set.
+	IsPaid *bool
+	From   time.Time
+	To     time.Time
+	// IsOneOffCharge filters one-off charges or regular payments if set.
+	IsOneOffCharge *bool
+}
+
+// encodeURLValues encodes options as URL parameters.
+func (options *ListPaymentsOptions) encodeURLValues() (url.Values, error) {
+	values := make(url.Values)
+	if options.SubscriptionID != 0 {
+		values.Set("subscription_id", strconv.FormatUint(options.SubscriptionID, 10))
+	}
+	if options.PlanID != 0 {
+		values.Set("plan", strconv.FormatUint(options.PlanID, 10))
+	}
+	if options.IsPaid != nil {
+		if *options.IsPaid {
+			values.Set("is_paid", "1")
+		} else {
+			values.Set("is_paid", "0")
+		}
+	}
+	if !options.From.IsZero() && !options.To.IsZero() && options.To.Before(options.From) {
+		return nil, errors.New("\"to\" can't be before \"from\"")
+	}
+	if !options.From.IsZero() {
+		values.Set("from", options.From.Format("2006-01-02"))
+	}
+	if !options.To.IsZero() {
+		values.Set("to", options.To.Format("2006-01-02"))
+	}
+	setBool(values, "is_one_off_charge", options.IsOneOffCharge)
+
+	return values, nil
+}
+
+// List returns subscription payments matching the options.
+//
+// Paddle docs: https://developer.paddle.com/api-reference/subscription-api/payments/listpayments
+func (payments *Payments) List(ctx context.Context, options *ListPaymentsOptions) ([]*Payment, *http.Response, error) {
+	path := "2.0/subscription/payments"
+
+	if options == nil {
+		options = new(ListPaymentsOptions)
+	}
+	request, err := newRequest(ctx, http.MethodPost, payments.baseURL, path, payments.authentication, options)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create new request: %w", err)
+	}
+
+	response := new(response[[]*Payment])
+	httpResponse, err := doRequest((*api)(payments), request, response)
+	if err != nil {
+		return nil, httpResponse, err
+	}
+
+	return response.value(), httpResponse, nil
+}
+
+// ReschedulePaymentOptions represents options for rescheduling an upcoming payment.
+type ReschedulePaymentOptions struct {
+	PaymentID uint64
+	Date      time.Time
+}
+
+// encodeURLValues encodes options as URL parameters.
+func (options *ReschedulePaymentOptions) encodeURLValues() (url.Values, error) {
+	values := make(url.Values)
+	if options.PaymentID == 0 {
+		return nil, errors.New("\"payment_id\" is required")
+	}
+	if options.Date.IsZero() {
+		return nil, errors.New("\"date\" is required")
+	}
+
+	values.Set("payment_id", strconv.FormatUint(options.PaymentID, 10))
+	values.Set("date", options.Date.Format("2006-01-02"))
+
+	return values, nil
+}
+
+// Reschedule moves the upcoming subscription payment to another date.
+//
+// Paddle docs: https://developer.paddle.com/api-reference/subscription-api/payments/updatepayment
+func (payments *Payments) Reschedule(ctx context.Context, options *ReschedulePaymentOptions) (*http.Response, error) {
+	path := "2.0/subscription/payments_reschedule"
+
+	if options == nil {
+		options = new(ReschedulePaymentOptions)
+	}
+	request, err := newRequest(ctx, http.MethodPost, payments.baseURL, path, payments.authentication, options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create new request: %w", err)
+	}
+
+	httpResponse, err := doRequest((*api)(payments), request, new(response[interface{}]))
+	if err != nil {
+		return httpResponse, err
+	}
+
+	return httpResponse, nil
+}
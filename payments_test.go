@@ -0,0 +1,137 @@
+package paddle
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestPaymentsListOnSuccess(t *testing.T) {
+	var form url.Values
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		ok(t, req.ParseForm())
+		form = req.PostForm
+
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBuffer([]byte(paymentsListJSON))),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	u, _ := url.Parse(sandboxBaseURL)
+	payments := Payments{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}}
+
+	result, _, err := payments.List(context.Background(), &ListPaymentsOptions{
+		SubscriptionID: 2746,
+		IsPaid:         Bool(false),
+		From:           time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+		To:             time.Date(2022, 12, 31, 0, 0, 0, 0, time.UTC),
+	})
+	ok(t, err)
+
+	equals(t, "2746", form.Get("subscription_id"))
+	equals(t, "0", form.Get("is_paid"))
+	equals(t, "2022-01-01", form.Get("from"))
+	equals(t, "2022-12-31", form.Get("to"))
+	equals(t, false, form.Has("is_one_off_charge"))
+	equals(t, []*Payment{
+		{ID: 8936, SubscriptionID: 2746, Amount: 1, Currency: "USD", PayoutDate: "2022-10-15", IsPaid: true, IsOneOffCharge: false, ReceiptURL: "https://sandbox-my.paddle.com/receipt/469214-8936/1940881-chre8a3e7bd0ef6-0b5fa3c327"},
+		{ID: 8937, SubscriptionID: 2746, Amount: 5.5, Currency: "USD", PayoutDate: "2022-11-15", IsPaid: false, IsOneOffCharge: true},
+	}, result)
+}
+
+func TestPaymentsListOnValidationError(t *testing.T) {
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		return nil, nil
+	})
+	u, _ := url.Parse(sandboxBaseURL)
+	payments := Payments{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}}
+
+	_, _, err := payments.List(context.Background(), &ListPaymentsOptions{
+		From: time.Date(2022, 12, 31, 0, 0, 0, 0, time.UTC),
+		To:   time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+	})
+	errorred(t, err, "\"to\" can't be before \"from\"")
+}
+
+func TestPaymentsListOnBrokenFlag(t *testing.T) {
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBuffer([]byte(`{"success": true, "response": [{"id": 1, "is_paid": "maybe"}]}`))),
+			Header:     make(http.Header),
+		}, nil
+	})
+	u, _ := url.Parse(sandboxBaseURL)
+	payments := Payments{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}}
+
+	_, _, err := payments.List(context.Background(), nil)
+	errorred(t, err, "failed to decode \"is_paid\"")
+}
+
+func TestPaymentsRescheduleOnValidationError(t *testing.T) {
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		return nil, nil
+	})
+	u, _ := url.Parse(sandboxBaseURL)
+	payments := Payments{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}}
+
+	_, err := payments.Reschedule(context.Background(), &ReschedulePaymentOptions{})
+	errorred(t, err, "\"payment_id\" is required")
+
+	_, err = payments.Reschedule(context.Background(), &ReschedulePaymentOptions{PaymentID: 8937})
+	errorred(t, err, "\"date\" is required")
+}
+
+func TestPaymentsRescheduleOnSuccess(t *testing.T) {
+	var form url.Values
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		ok(t, req.ParseForm())
+		form = req.PostForm
+
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBuffer([]byte(usersCancelJSON))),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	u, _ := url.Parse(sandboxBaseURL)
+	payments := Payments{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}}
+
+	_, err := payments.Reschedule(context.Background(), &ReschedulePaymentOptions{PaymentID: 8937, Date: time.Date(2022, 11, 20, 0, 0, 0, 0, time.UTC)})
+	ok(t, err)
+
+	equals(t, "8937", form.Get("payment_id"))
+	equals(t, "2022-11-20", form.Get("date"))
+}
+
+const paymentsListJSON = `{
+    "success": true,
+    "response": [
+        {
+            "id": 8936,
+            "subscription_id": 2746,
+            "amount": 1,
+            "currency": "USD",
+            "payout_date": "2022-10-15",
+            "is_paid": 1,
+            "is_one_off_charge": false,
+            "receipt_url": "https://sandbox-my.paddle.com/receipt/469214-8936/1940881-chre8a3e7bd0ef6-0b5fa3c327"
+        },
+        {
+            "id": 8937,
+            "subscription_id": 2746,
+            "amount": 5.5,
+            "currency": "USD",
+            "payout_date": "2022-11-15",
+            "is_paid": 0,
+            "is_one_off_charge": true
+        }
+    ]
+}`
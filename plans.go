@@ -0,0 +1,177 @@
+package paddle
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Plans is an API to work with the Paddle subscription plans.
+type Plans api
+
+// BillingType represents subscription plan billing interval type: day, week, month or year.
+type BillingType string
+
+const (
+	// BillingDay represents daily billing.
+	BillingDay BillingType = "day"
+	// BillingWeek represents weekly billing.
+	BillingWeek BillingType = "week"
+	// BillingMonth represents monthly billing.
+	BillingMonth BillingType = "month"
+	// BillingYear represents yearly billing.
+	BillingYear BillingType = "year"
+)
+
+// Plan represents a Paddle subscription plan.
+type Plan struct {
+	ID            uint64      `json:"id,omitempty"`
+	Name          string      `json:"name,omitempty"`
+	BillingType   BillingType `json:"billing_type,omitempty"`
+	BillingPeriod int         `json:"billing_period,omitempty"`
+	// InitialPrice maps currencies to the initial prices.
+	InitialPrice map[string]json.Number `json:"initial_price,omitempty"`
+	// RecurringPrice maps currencies to the recurring prices.
+	RecurringPrice map[string]json.Number `json:"recurring_price,omitempty"`
+	TrialDays      int                    `json:"trial_days,omitempty"`
+}
+
+// listPlansOptions represents options for listing subscription plans.
+type listPlansOptions struct {
+	planID uint64
+}
+
+// encodeURLValues encodes options as URL parameters.
+func (options *listPlansOptions) encodeURLValues() (url.Values, error) {
+	values := make(url.Values)
+	if options.planID != 0 {
+		values.Set("plan", strconv.FormatUint(options.planID, 10))
+	}
+
+	return values, nil
+}
+
+// List returns subscription plans, only the plan with the specified ID is returned if planID is not zero.
+//
+// Paddle docs: https://developer.paddle.com/api-reference/subscription-api/plans/listplans
+func (plans *Plans) List(ctx context.Context, planID uint64) ([]*Plan, *http.Response, error) {
+	path := "2.0/subscription/plans"
+
+	request, err := newRequest(ctx, http.MethodPost, plans.baseURL, path, plans.authentication, &listPlansOptions{planID: planID})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create new request: %w", err)
+	}
+
+	response := new(response[[]*Plan])
+	httpResponse, err := doRequest((*api)(plans), request, response)
+	if err != nil {
+		return nil, httpResponse, err
+	}
+
+	return response.value(), httpResponse, nil
+}
+
+// planCurrencies lists currencies supported for subscription plan prices.
+var planCurrencies = []string{"USD", "GBP", "EUR"}
+
+// CreatePlanOptions represents options for creating a subscription plan.
+type CreatePlanOptions struct {
+	PlanName string
+	PlanType BillingType
+	// PlanLength is the number of billing intervals between payments.
+	PlanLength    int
+	PlanTrialDays int
+	// MainCurrencyCode is one of "USD", "GBP" or "EUR", its recurring price is required.
+	MainCurrencyCode string
+	// RecurringPrices maps "USD", "GBP" and "EUR" to the recurring prices.
+	RecurringPrices map[string]string
+}
+
+// encodeURLValues encodes options as URL parameters.
+func (options *CreatePlanOptions) encodeURLValues() (url.Values, error) {
+	values := make(url.Values)
+	if options.PlanName == "" {
+		return nil, errors.New("\"plan_name\" is required")
+	}
+
+	switch options.PlanType {
+	case BillingDay, BillingWeek, BillingMonth, BillingYear:
+	default:
+		return nil, errors.New("\"plan_type\" must be one of \"day\", \"week\", \"month\", \"year\"")
+	}
+
+	if options.PlanLength <= 0 {
+		return nil, errors.New("\"plan_length\" must be positive")
+	}
+	if options.PlanTrialDays < 0 {
+		return nil, errors.New("\"plan_trial_days\" can't be negative")
+	}
+	if !isPlanCurrency(options.MainCurrencyCode) {
+		return nil, errors.New("\"main_currency_code\" must be one of \"USD\", \"GBP\", \"EUR\"")
+	}
+	if _, ok := options.RecurringPrices[options.MainCurrencyCode]; !ok {
+		return nil, fmt.Errorf("recurring price in the main currency %s is required", options.MainCurrencyCode)
+	}
+
+	values.Set("plan_name", options.PlanName)
+	values.Set("plan_type", string(options.PlanType))
+	values.Set("plan_length", strconv.Itoa(options.PlanLength))
+	values.Set("plan_trial_days", strconv.Itoa(options.PlanTrialDays))
+	values.Set("main_currency_code", options.MainCurrencyCode)
+	for currency, price := range options.RecurringPrices {
+		if !isPlanCurrency(currency) {
+			return nil, fmt.Errorf("unsupported recurring price currency %s, must be one of \"USD\", \"GBP\", \"EUR\"", currency)
+		}
+		if err := validateAmount(price); err != nil {
+			return nil, fmt.Errorf("recurring price in %s %w", currency, err)
+		}
+
+		values.Set("recurring_price_"+strings.ToLower(currency), price)
+	}
+
+	return values, nil
+}
+
+// isPlanCurrency reports whether the currency is supported for subscription plan prices.
+func isPlanCurrency(currency string) bool {
+	for _, c := range planCurrencies {
+		if c == currency {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CreatePlanResponse represents a response for the create subscription plan request.
+type CreatePlanResponse struct {
+	ProductID uint64 `json:"product_id,omitempty"`
+}
+
+// Create creates a new subscription plan.
+//
+// Paddle docs: https://developer.paddle.com/api-reference/subscription-api/plans/createplan
+func (plans *Plans) Create(ctx context.Context, options *CreatePlanOptions) (*CreatePlanResponse, *http.Response, error) {
+	path := "2.0/subscription/plans_create"
+
+	if options == nil {
+		options = new(CreatePlanOptions)
+	}
+	request, err := newRequest(ctx, http.MethodPost, plans.baseURL, path, plans.authentication, options)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create new request: %w", err)
+	}
+
+	response := new(response[*CreatePlanResponse])
+	httpResponse, err := doNonIdempotentRequest((*api)(plans), request, response)
+	if err != nil {
+		return nil, httpResponse, err
+	}
+
+	return response.value(), httpResponse, nil
+}
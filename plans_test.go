@@ -0,0 +1,140 @@
+package paddle
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestPlansListOnSuccess(t *testing.T) {
+	var form url.Values
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		ok(t, req.ParseForm())
+		form = req.PostForm
+
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBuffer([]byte(plansListJSON))),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	u, _ := url.Parse(sandboxBaseURL)
+	plans := Plans{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}}
+
+	result, _, err := plans.List(context.Background(), 9092)
+	ok(t, err)
+
+	equals(t, "9092", form.Get("plan"))
+	equals(t, []*Plan{{
+		ID:             9092,
+		Name:           "Monthly subscription",
+		BillingType:    BillingMonth,
+		BillingPeriod:  1,
+		InitialPrice:   map[string]json.Number{"USD": "0.00"},
+		RecurringPrice: map[string]json.Number{"USD": "7.00", "EUR": "6.5"},
+		TrialDays:      14,
+	}}, result)
+}
+
+func TestPlansListOnAPIError(t *testing.T) {
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBuffer([]byte(usersListErrorJSON))),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	u, _ := url.Parse(sandboxBaseURL)
+	plans := Plans{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}}
+
+	_, _, err := plans.List(context.Background(), 0)
+	equals(t, &APIError{102, "Bad api key"}, err)
+}
+
+func TestPlansCreateOnValidationError(t *testing.T) {
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		return nil, nil
+	})
+	u, _ := url.Parse(sandboxBaseURL)
+	plans := Plans{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}}
+
+	cases := map[string]*CreatePlanOptions{
+		"\"plan_name\" is required":             {},
+		"\"plan_type\" must be one of":          {PlanName: "Pro", PlanType: "decade"},
+		"\"plan_length\" must be positive":      {PlanName: "Pro", PlanType: BillingMonth},
+		"\"main_currency_code\" must be one of": {PlanName: "Pro", PlanType: BillingMonth, PlanLength: 1, MainCurrencyCode: "JPY"},
+		"recurring price in the main currency":  {PlanName: "Pro", PlanType: BillingMonth, PlanLength: 1, MainCurrencyCode: "USD"},
+		"unsupported recurring price currency":  {PlanName: "Pro", PlanType: BillingMonth, PlanLength: 1, MainCurrencyCode: "USD", RecurringPrices: map[string]string{"USD": "7.00", "JPY": "1000"}},
+		"recurring price in USD must be":        {PlanName: "Pro", PlanType: BillingMonth, PlanLength: 1, MainCurrencyCode: "USD", RecurringPrices: map[string]string{"USD": "free"}},
+	}
+	for message, options := range cases {
+		_, _, err := plans.Create(context.Background(), options)
+		errorred(t, err, message)
+	}
+}
+
+func TestPlansCreateOnSuccess(t *testing.T) {
+	var form url.Values
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		ok(t, req.ParseForm())
+		form = req.PostForm
+
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBuffer([]byte(plansCreateJSON))),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	u, _ := url.Parse(sandboxBaseURL)
+	plans := Plans{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}}
+
+	result, _, err := plans.Create(context.Background(), &CreatePlanOptions{
+		PlanName:         "Pro",
+		PlanType:         BillingYear,
+		PlanLength:       1,
+		PlanTrialDays:    7,
+		MainCurrencyCode: "USD",
+		RecurringPrices:  map[string]string{"USD": "70.00", "EUR": "65.00"},
+	})
+	ok(t, err)
+
+	equals(t, &CreatePlanResponse{ProductID: 502198}, result)
+	equals(t, "year", form.Get("plan_type"))
+	equals(t, "7", form.Get("plan_trial_days"))
+	equals(t, "70.00", form.Get("recurring_price_usd"))
+	equals(t, "65.00", form.Get("recurring_price_eur"))
+}
+
+const plansListJSON = `{
+    "success": true,
+    "response": [
+        {
+            "id": 9092,
+            "name": "Monthly subscription",
+            "billing_type": "month",
+            "billing_period": 1,
+            "initial_price": {
+                "USD": "0.00"
+            },
+            "recurring_price": {
+                "USD": "7.00",
+                "EUR": 6.5
+            },
+            "trial_days": 14
+        }
+    ]
+}`
+
+const plansCreateJSON = `{
+    "success": true,
+    "response": {
+        "product_id": 502198
+    }
+}`
@@ -0,0 +1,174 @@
+package paddle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Products is an API to work with the Paddle products and pay links.
+type Products api
+
+// Product represents a Paddle product.
+type Product struct {
+	ID          uint64   `json:"id,omitempty"`
+	Name        string   `json:"name,omitempty"`
+	Description string   `json:"description,omitempty"`
+	BasePrice   float64  `json:"base_price,omitempty"`
+	SalePrice   *float64 `json:"sale_price,omitempty"`
+	Currency    string   `json:"currency,omitempty"`
+	Screenshots []string `json:"screenshots,omitempty"`
+	Icon        string   `json:"icon,omitempty"`
+}
+
+// ListProductsResponse represents a response for the list products request.
+type ListProductsResponse struct {
+	Total    int        `json:"total,omitempty"`
+	Count    int        `json:"count,omitempty"`
+	Products []*Product `json:"products,omitempty"`
+}
+
+// List returns all published one-time products.
+//
+// Paddle docs: https://developer.paddle.com/api-reference/product-api/products/getproducts
+func (products *Products) List(ctx context.Context) (*ListProductsResponse, *http.Response, error) {
+	path := "2.0/product/get_products"
+
+	request, err := newRequest(ctx, http.MethodPost, products.baseURL, path, products.authentication, new(emptyOptions))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create new request: %w", err)
+	}
+
+	response := new(response[*ListProductsResponse])
+	httpResponse, err := doRequest((*api)(products), request, response)
+	if err != nil {
+		return nil, httpResponse, err
+	}
+
+	return response.value(), httpResponse, nil
+}
+
+// GeneratePayLinkOptions represents options for generating a pay link.
+// Either ProductID or Title with WebhookURL for a custom non-catalog product is required.
+type GeneratePayLinkOptions struct {
+	ProductID uint64
+	// Title is the name of a custom non-catalog product.
+	Title string
+	// WebhookURL receives the fulfillment webhook for a custom non-catalog product.
+	WebhookURL string
+	// Prices maps currencies to prices, e.g. "USD" to "19.99".
+	Prices map[string]string
+	// RecurringPrices maps currencies to recurring prices of a subscription plan.
+	RecurringPrices         map[string]string
+	TrialDays               int
+	CustomMessage           string
+	CouponCode              string
+	Discountable            *bool
+	ImageURL                string
+	ReturnURL               string
+	QuantityVariable        *bool
+	Quantity                int
+	Expires                 time.Time
+	Affiliates              []string
+	RecurringAffiliateLimit int
+	MarketingConsent        *bool
+	CustomerEmail           string
+	CustomerCountry         string
+	CustomerPostcode        string
+	IsRecoverable           *bool
+	Passthrough             string
+	VATNumber               string
+	VATCompanyName          string
+	VATStreet               string
+	VATCity                 string
+	VATState                string
+	VATCountry              string
+	VATPostcode             string
+}
+
+// encodeURLValues encodes options as URL parameters.
+func (options *GeneratePayLinkOptions) encodeURLValues() (url.Values, error) {
+	values := make(url.Values)
+	if options.ProductID != 0 {
+		values.Set("product_id", strconv.FormatUint(options.ProductID, 10))
+	} else if options.Title == "" || options.WebhookURL == "" {
+		return nil, errors.New("\"product_id\" or both \"title\" and \"webhook_url\" are required")
+	}
+
+	setString(values, "title", options.Title)
+	setString(values, "webhook_url", options.WebhookURL)
+	setPrices(values, "prices", options.Prices)
+	setPrices(values, "recurring_prices", options.RecurringPrices)
+	if options.TrialDays < 0 {
+		return nil, errors.New("\"trial_days\" can't be negative")
+	} else if options.TrialDays > 0 {
+		values.Set("trial_days", strconv.Itoa(options.TrialDays))
+	}
+	setString(values, "custom_message", options.CustomMessage)
+	setString(values, "coupon_code", options.CouponCode)
+	setBool(values, "discountable", options.Discountable)
+	setString(values, "image_url", options.ImageURL)
+	setString(values, "return_url", options.ReturnURL)
+	setBool(values, "quantity_variable", options.QuantityVariable)
+	if options.Quantity < 0 {
+		return nil, errors.New("\"quantity\" can't be negative")
+	} else if options.Quantity > 0 {
+		values.Set("quantity", strconv.Itoa(options.Quantity))
+	}
+	if !options.Expires.IsZero() {
+		values.Set("expires", options.Expires.Format("2006-01-02"))
+	}
+	for i, affiliate := range options.Affiliates {
+		values.Set(fmt.Sprintf("affiliates[%d]", i), affiliate)
+	}
+	if options.RecurringAffiliateLimit > 0 {
+		values.Set("recurring_affiliate_limit", strconv.Itoa(options.RecurringAffiliateLimit))
+	}
+	setBool(values, "marketing_consent", options.MarketingConsent)
+	setString(values, "customer_email", options.CustomerEmail)
+	setString(values, "customer_country", options.CustomerCountry)
+	setString(values, "customer_postcode", options.CustomerPostcode)
+	setBool(values, "is_recoverable", options.IsRecoverable)
+	setString(values, "passthrough", options.Passthrough)
+	setString(values, "vat_number", options.VATNumber)
+	setString(values, "vat_company_name", options.VATCompanyName)
+	setString(values, "vat_street", options.VATStreet)
+	setString(values, "vat_city", options.VATCity)
+	setString(values, "vat_state", options.VATState)
+	setString(values, "vat_country", options.VATCountry)
+	setString(values, "vat_postcode", options.VATPostcode)
+
+	return values, nil
+}
+
+// GeneratePayLinkResponse represents a response for the generate pay link request.
+type GeneratePayLinkResponse struct {
+	URL string `json:"url,omitempty"`
+}
+
+// GeneratePayLink generates a link with custom prices, messages and customer details for a checkout.
+//
+// Paddle docs: https://developer.paddle.com/api-reference/product-api/pay-links/createpaylink
+func (products *Products) GeneratePayLink(ctx context.Context, options *GeneratePayLinkOptions) (*GeneratePayLinkResponse, *http.Response, error) {
+	path := "2.0/product/generate_pay_link"
+
+	if options == nil {
+		options = new(GeneratePayLinkOptions)
+	}
+	request, err := newRequest(ctx, http.MethodPost, products.baseURL, path, products.authentication, options)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create new request: %w", err)
+	}
+
+	response := new(response[*GeneratePayLinkResponse])
+	httpResponse, err := doRequest((*api)(products), request, response)
+	if err != nil {
+		return nil, httpResponse, err
+	}
+
+	return response.value(), httpResponse, nil
+}
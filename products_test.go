@@ -0,0 +1,144 @@
+package paddle
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestProductsListOnSuccess(t *testing.T) {
+	expectedResponse := &http.Response{
+		StatusCode: 200,
+		Body:       ioutil.NopCloser(bytes.NewBuffer([]byte(productsListJSON))),
+		Header:     make(http.Header),
+	}
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		return expectedResponse, nil
+	})
+
+	u, _ := url.Parse(sandboxBaseURL)
+	products := Products{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}}
+
+	result, actualResponse, err := products.List(context.Background())
+	ok(t, err)
+
+	equals(t, expectedResponse, actualResponse)
+	equals(t, 2, result.Total)
+	equals(t, &Product{ID: 489171, Name: "A Product", Description: "A description of the product.", BasePrice: 58, Currency: "USD", Screenshots: []string{}, Icon: "https://paddle-static.s3.amazonaws.com/email/2013-04-10/og.png"}, result.Products[0])
+	equals(t, 38.5, *result.Products[1].SalePrice)
+}
+
+func TestProductsListOnAPIError(t *testing.T) {
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBuffer([]byte(usersListErrorJSON))),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	u, _ := url.Parse(sandboxBaseURL)
+	products := Products{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}}
+
+	_, _, err := products.List(context.Background())
+	equals(t, &APIError{102, "Bad api key"}, err)
+}
+
+func TestProductsGeneratePayLinkOnValidationError(t *testing.T) {
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		return nil, nil
+	})
+	u, _ := url.Parse(sandboxBaseURL)
+	products := Products{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}}
+
+	_, _, err := products.GeneratePayLink(context.Background(), &GeneratePayLinkOptions{Title: "Custom product"})
+	errorred(t, err, "\"product_id\" or both \"title\" and \"webhook_url\" are required")
+
+	_, _, err = products.GeneratePayLink(context.Background(), &GeneratePayLinkOptions{ProductID: 42, Quantity: -1})
+	errorred(t, err, "\"quantity\" can't be negative")
+}
+
+func TestProductsGeneratePayLinkOnSuccess(t *testing.T) {
+	var form url.Values
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		ok(t, req.ParseForm())
+		form = req.PostForm
+
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBuffer([]byte(productsGeneratePayLinkJSON))),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	u, _ := url.Parse(sandboxBaseURL)
+	products := Products{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}}
+
+	result, _, err := products.GeneratePayLink(context.Background(), &GeneratePayLinkOptions{
+		ProductID:        12345,
+		Prices:           map[string]string{"USD": "19.99", "EUR": "17.99"},
+		TrialDays:        14,
+		CustomMessage:    "Thanks for trying us out",
+		Discountable:     Bool(false),
+		Quantity:         3,
+		Expires:          time.Date(2022, 12, 31, 0, 0, 0, 0, time.UTC),
+		CustomerEmail:    "qa@screenshotone.com",
+		CustomerCountry:  "US",
+		CustomerPostcode: "10001",
+		Passthrough:      `{"account_id":42}`,
+	})
+	ok(t, err)
+
+	equals(t, &GeneratePayLinkResponse{URL: "https://sandbox-checkout.paddle.com/checkout/custom/eyJ0IjoiUHJvZHVjdCIsImkiOiIxMjM0NSJ9"}, result)
+	equals(t, "12345", form.Get("product_id"))
+	equals(t, "EUR:17.99", form.Get("prices[0]"))
+	equals(t, "USD:19.99", form.Get("prices[1]"))
+	equals(t, "14", form.Get("trial_days"))
+	equals(t, "false", form.Get("discountable"))
+	equals(t, "", form.Get("quantity_variable"))
+	equals(t, "3", form.Get("quantity"))
+	equals(t, "2022-12-31", form.Get("expires"))
+	equals(t, `{"account_id":42}`, form.Get("passthrough"))
+	equals(t, "42", form.Get("vendor_id"))
+}
+
+const productsListJSON = `{
+    "success": true,
+    "response": {
+        "total": 2,
+        "count": 2,
+        "products": [
+            {
+                "id": 489171,
+                "name": "A Product",
+                "description": "A description of the product.",
+                "base_price": 58,
+                "sale_price": null,
+                "screenshots": [],
+                "icon": "https://paddle-static.s3.amazonaws.com/email/2013-04-10/og.png",
+                "currency": "USD"
+            },
+            {
+                "id": 489278,
+                "name": "Another Product",
+                "description": null,
+                "base_price": 39.99,
+                "sale_price": 38.5,
+                "screenshots": [],
+                "icon": "https://paddle-static.s3.amazonaws.com/email/2013-04-10/og.png",
+                "currency": "GBP"
+            }
+        ]
+    }
+}`
+
+const productsGeneratePayLinkJSON = `{
+    "success": true,
+    "response": {
+        "url": "https://sandbox-checkout.paddle.com/checkout/custom/eyJ0IjoiUHJvZHVjdCIsImkiOiIxMjM0NSJ9"
+    }
+}`
@@ -0,0 +1,139 @@
+package paddle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RateLimit represents a token bucket quota: requests per second with the allowed burst.
+type RateLimit struct {
+	// RequestsPerSecond is the rate at which the bucket is refilled.
+	RequestsPerSecond float64
+	// Burst is the maximum number of requests sent at once.
+	Burst int
+}
+
+// validate checks that the rate limit is usable.
+func (limit RateLimit) validate() error {
+	if limit.RequestsPerSecond <= 0 {
+		return errors.New("\"RequestsPerSecond\" must be positive")
+	}
+	if limit.Burst < 1 {
+		return errors.New("\"Burst\" must be at least 1")
+	}
+
+	return nil
+}
+
+// RateLimiter throttles requests to the Paddle API, it is safe for concurrent use
+// and can be shared between clients.
+type RateLimiter struct {
+	bucket    *tokenBucket
+	endpoints map[string]*tokenBucket
+}
+
+// NewRateLimiter creates a new rate limiter with the default limit applied to all endpoints.
+// Overrides are keyed by the API path, like "2.0/subscription/users", and replace the default limit
+// for the matching endpoint.
+func NewRateLimiter(limit RateLimit, overrides map[string]RateLimit) (*RateLimiter, error) {
+	if err := limit.validate(); err != nil {
+		return nil, fmt.Errorf("invalid rate limit: %w", err)
+	}
+
+	endpoints := make(map[string]*tokenBucket, len(overrides))
+	for endpoint, override := range overrides {
+		if err := override.validate(); err != nil {
+			return nil, fmt.Errorf("invalid rate limit for %s: %w", endpoint, err)
+		}
+		endpoints[strings.Trim(endpoint, "/")] = newTokenBucket(override)
+	}
+
+	return &RateLimiter{bucket: newTokenBucket(limit), endpoints: endpoints}, nil
+}
+
+// WithRateLimiter throttles all API sections of the client with the specified limiter.
+func WithRateLimiter(limiter *RateLimiter) Option {
+	return func(options *clientOptions) error {
+		if limiter == nil {
+			return errors.New("rate limiter can't be nil")
+		}
+		options.rateLimiter = limiter
+
+		return nil
+	}
+}
+
+// Wait blocks until a request to the endpoint is allowed or the context is done.
+func (limiter *RateLimiter) Wait(ctx context.Context, endpoint string) error {
+	bucket := limiter.bucket
+	if b, ok := limiter.endpoints[strings.Trim(endpoint, "/")]; ok {
+		bucket = b
+	}
+
+	return bucket.wait(ctx)
+}
+
+// tokenBucket implements the token bucket algorithm.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// newTokenBucket creates a new full token bucket.
+func newTokenBucket(limit RateLimit) *tokenBucket {
+	return &tokenBucket{
+		rate:   limit.RequestsPerSecond,
+		burst:  float64(limit.Burst),
+		tokens: float64(limit.Burst),
+		last:   time.Now(),
+	}
+}
+
+// reserve takes a token and returns how long to wait before using it.
+func (bucket *tokenBucket) reserve() time.Duration {
+	bucket.mu.Lock()
+	defer bucket.mu.Unlock()
+
+	now := time.Now()
+	elapsed := now.Sub(bucket.last).Seconds()
+	bucket.tokens = math.Min(bucket.burst, bucket.tokens+elapsed*bucket.rate)
+	bucket.last = now
+
+	bucket.tokens--
+	if bucket.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-bucket.tokens / bucket.rate * float64(time.Second))
+}
+
+// release returns the unused token.
+func (bucket *tokenBucket) release() {
+	bucket.mu.Lock()
+	defer bucket.mu.Unlock()
+
+	bucket.tokens = math.Min(bucket.burst, bucket.tokens+1)
+}
+
+// wait blocks until a token is available or the context is done.
+func (bucket *tokenBucket) wait(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	delay := bucket.reserve()
+	if err := sleep(ctx, delay); err != nil {
+		bucket.release()
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package paddle
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterValidation(t *testing.T) {
+	_, err := NewRateLimiter(RateLimit{RequestsPerSecond: 0, Burst: 1}, nil)
+	errorred(t, err, "\"RequestsPerSecond\" must be positive")
+
+	_, err = NewRateLimiter(RateLimit{RequestsPerSecond: 1, Burst: 1}, map[string]RateLimit{"2.0/subscription/users": {RequestsPerSecond: 1}})
+	errorred(t, err, "invalid rate limit for 2.0/subscription/users")
+}
+
+func TestRateLimiterAllowsBurst(t *testing.T) {
+	limiter, err := NewRateLimiter(RateLimit{RequestsPerSecond: 1, Burst: 3}, nil)
+	ok(t, err)
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		ok(t, limiter.Wait(context.Background(), "2.0/subscription/users"))
+	}
+	if elapsed := time.Since(start); elapsed > 100*time.Millisecond {
+		t.Fatalf("burst requests must not be throttled, but took %s", elapsed)
+	}
+}
+
+func TestRateLimiterThrottles(t *testing.T) {
+	limiter, err := NewRateLimiter(RateLimit{RequestsPerSecond: 20, Burst: 1}, nil)
+	ok(t, err)
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		ok(t, limiter.Wait(context.Background(), "2.0/subscription/users"))
+	}
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Fatalf("requests must be throttled, but took %s", elapsed)
+	}
+}
+
+func TestRateLimiterRespectsContext(t *testing.T) {
+	limiter, err := NewRateLimiter(RateLimit{RequestsPerSecond: 0.1, Burst: 1}, nil)
+	ok(t, err)
+	ok(t, limiter.Wait(context.Background(), "2.0/subscription/users"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err = limiter.Wait(ctx, "2.0/subscription/users")
+	equals(t, true, errors.Is(err, context.DeadlineExceeded))
+}
+
+func TestRateLimiterEndpointOverrides(t *testing.T) {
+	limiter, err := NewRateLimiter(RateLimit{RequestsPerSecond: 0.1, Burst: 1}, map[string]RateLimit{
+		"2.0/subscription/users": {RequestsPerSecond: 1, Burst: 5},
+	})
+	ok(t, err)
+
+	var paths []string
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		paths = append(paths, req.URL.Path)
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBuffer([]byte(usersListJSON))),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	u, _ := url.Parse(sandboxBaseURL)
+	users := Users{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}, rateLimiter: limiter}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	for i := 0; i < 5; i++ {
+		_, _, err := users.List(ctx, nil)
+		ok(t, err)
+	}
+	equals(t, 5, len(paths))
+}
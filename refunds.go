@@ -0,0 +1,70 @@
+package paddle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Refunds is an API to work with the Paddle refunds.
+type Refunds api
+
+// RefundPaymentOptions represents options for refunding a payment.
+type RefundPaymentOptions struct {
+	// OrderID identifies the one-off or subscription payment.
+	OrderID string
+	// Amount is refunded partially if set, otherwise the payment is refunded in full.
+	Amount string
+	Reason string
+}
+
+// encodeURLValues encodes options as URL parameters.
+func (options *RefundPaymentOptions) encodeURLValues() (url.Values, error) {
+	values := make(url.Values)
+	if options.OrderID == "" {
+		return nil, errors.New("\"order_id\" is required")
+	}
+	if options.Amount != "" {
+		if err := validateAmount(options.Amount); err != nil {
+			return nil, fmt.Errorf("\"amount\" %w", err)
+		}
+	}
+
+	values.Set("order_id", options.OrderID)
+	setString(values, "amount", options.Amount)
+	setString(values, "reason", options.Reason)
+
+	return values, nil
+}
+
+// RefundPaymentResponse represents a response for the refund payment request.
+type RefundPaymentResponse struct {
+	RefundRequestID uint64 `json:"refund_request_id,omitempty"`
+}
+
+// Refund requests a full or partial refund of the payment.
+// The refund is processed asynchronously, the refund type is reported with the RefundType
+// of SubscriptionPaymentRefundedAlert or PaymentRefundedAlert.
+//
+// Paddle docs: https://developer.paddle.com/api-reference/product-api/payments/refundpayment
+func (refunds *Refunds) Refund(ctx context.Context, options *RefundPaymentOptions) (*RefundPaymentResponse, *http.Response, error) {
+	path := "2.0/payment/refund"
+
+	if options == nil {
+		options = new(RefundPaymentOptions)
+	}
+	request, err := newRequest(ctx, http.MethodPost, refunds.baseURL, path, refunds.authentication, options)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create new request: %w", err)
+	}
+
+	response := new(response[*RefundPaymentResponse])
+	httpResponse, err := doNonIdempotentRequest((*api)(refunds), request, response)
+	if err != nil {
+		return nil, httpResponse, err
+	}
+
+	return response.value(), httpResponse, nil
+}
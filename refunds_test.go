@@ -0,0 +1,85 @@
+package paddle
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestRefundsRefundOnValidationError(t *testing.T) {
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		return nil, nil
+	})
+	u, _ := url.Parse(sandboxBaseURL)
+	refunds := Refunds{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}}
+
+	_, _, err := refunds.Refund(context.Background(), nil)
+	errorred(t, err, "\"order_id\" is required")
+
+	_, _, err = refunds.Refund(context.Background(), &RefundPaymentOptions{OrderID: "219233-384937", Amount: "-5"})
+	errorred(t, err, "\"amount\" must be a decimal number")
+}
+
+func TestRefundsRefundOnAPIError(t *testing.T) {
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBuffer([]byte(refundsRefundErrorJSON))),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	u, _ := url.Parse(sandboxBaseURL)
+	refunds := Refunds{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}}
+
+	_, _, err := refunds.Refund(context.Background(), &RefundPaymentOptions{OrderID: "219233-384937"})
+	equals(t, &APIError{110, "Unable to find requested purchase"}, err)
+	equals(t, true, IsNotFound(err))
+}
+
+func TestRefundsRefundOnSuccess(t *testing.T) {
+	var forms []url.Values
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		ok(t, req.ParseForm())
+		forms = append(forms, req.PostForm)
+
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBuffer([]byte(refundsRefundJSON))),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	u, _ := url.Parse(sandboxBaseURL)
+	refunds := Refunds{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}}
+
+	result, _, err := refunds.Refund(context.Background(), &RefundPaymentOptions{OrderID: "219233-384937"})
+	ok(t, err)
+	equals(t, &RefundPaymentResponse{RefundRequestID: 12345}, result)
+
+	result, _, err = refunds.Refund(context.Background(), &RefundPaymentOptions{OrderID: "219233-384937", Amount: "5.50", Reason: "Duplicate purchase"})
+	ok(t, err)
+	equals(t, &RefundPaymentResponse{RefundRequestID: 12345}, result)
+
+	equals(t, false, forms[0].Has("amount"))
+	equals(t, "5.50", forms[1].Get("amount"))
+	equals(t, "Duplicate purchase", forms[1].Get("reason"))
+}
+
+const refundsRefundJSON = `{
+    "success": true,
+    "response": {
+        "refund_request_id": 12345
+    }
+}`
+
+const refundsRefundErrorJSON = `{
+    "success": false,
+    "error": {
+        "code": 110,
+        "message": "Unable to find requested purchase"
+    }
+}`
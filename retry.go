@@ -0,0 +1,173 @@
+package paddle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultMaxAttempts = 3
+	defaultMinBackoff  = 500 * time.Millisecond
+	defaultMaxBackoff  = 10 * time.Second
+)
+
+// RetryPolicy describes how failed requests to the Paddle API are retried.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts, including the first one.
+	MaxAttempts int
+	// MinBackoff is the delay before the first retry, it doubles with every next attempt.
+	MinBackoff time.Duration
+	// MaxBackoff caps the delay between attempts.
+	MaxBackoff time.Duration
+	// Retryable decides whether the attempt should be retried,
+	// DefaultRetryable is used when it is nil.
+	Retryable func(response *http.Response, err error) bool
+	// RetryNonIdempotent enables retries for requests that can't be safely repeated,
+	// like charges or modifier creation. It is disabled by default to never double-bill a customer.
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy returns a retry policy with sensible defaults:
+// three attempts with an exponential backoff from 500ms to 10s.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: defaultMaxAttempts,
+		MinBackoff:  defaultMinBackoff,
+		MaxBackoff:  defaultMaxBackoff,
+		Retryable:   DefaultRetryable,
+	}
+}
+
+// WithRetryPolicy enables retries of transient failures with the specified policy.
+func WithRetryPolicy(policy *RetryPolicy) Option {
+	return func(options *clientOptions) error {
+		if policy == nil {
+			return errors.New("retry policy can't be nil")
+		}
+		if policy.MaxAttempts < 1 {
+			return errors.New("retry policy must allow at least one attempt")
+		}
+		if policy.MinBackoff < 0 || policy.MaxBackoff < 0 {
+			return errors.New("retry policy backoff can't be negative")
+		}
+		options.retryPolicy = policy
+
+		return nil
+	}
+}
+
+// DefaultRetryable reports whether the request failed due to a transient reason:
+// a network error, a server error or a rate limit.
+func DefaultRetryable(response *http.Response, err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var urlError *url.Error
+	if errors.As(err, &urlError) || IsRateLimited(err) {
+		return true
+	}
+
+	if response != nil {
+		return response.StatusCode == http.StatusTooManyRequests || response.StatusCode >= http.StatusInternalServerError
+	}
+
+	return false
+}
+
+// retryable reports whether the attempt should be retried.
+func (policy *RetryPolicy) retryable(response *http.Response, err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if policy.Retryable != nil {
+		return policy.Retryable(response, err)
+	}
+
+	return DefaultRetryable(response, err)
+}
+
+// backoff returns the delay before the next attempt, the "Retry-After" header is preferred if present.
+func (policy *RetryPolicy) backoff(attempt int, response *http.Response) time.Duration {
+	if response != nil {
+		if delay, ok := parseRetryAfter(response.Header.Get("Retry-After")); ok {
+			return delay
+		}
+	}
+
+	delay := policy.MinBackoff
+	for i := 1; i < attempt && delay < policy.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if policy.MaxBackoff > 0 && delay > policy.MaxBackoff {
+		delay = policy.MaxBackoff
+	}
+	if delay <= 0 {
+		return 0
+	}
+
+	// equal jitter: keep half of the delay and randomize the rest
+	half := delay / 2
+
+	return half + time.Duration(rand.Int63n(int64(delay-half)+1))
+}
+
+// parseRetryAfter parses the "Retry-After" header value in seconds or as an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		delay := time.Until(date)
+		if delay < 0 {
+			delay = 0
+		}
+
+		return delay, true
+	}
+
+	return 0, false
+}
+
+// sleep waits for the specified delay or until the context is done.
+func sleep(ctx context.Context, delay time.Duration) error {
+	if delay <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// retryRequest copies the request with a fresh body, so it can be sent once again.
+func retryRequest(request *http.Request) (*http.Request, error) {
+	retry := request.Clone(request.Context())
+	if request.GetBody != nil {
+		body, err := request.GetBody()
+		if err != nil {
+			return nil, fmt.Errorf("failed to copy request body: %w", err)
+		}
+		retry.Body = body
+	}
+
+	return retry, nil
+}
@@ -0,0 +1,120 @@
+package paddle
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestRetryOnServerError(t *testing.T) {
+	attempts := 0
+	var bodies []string
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		attempts++
+		body, _ := ioutil.ReadAll(req.Body)
+		bodies = append(bodies, string(body))
+		if attempts < 3 {
+			return &http.Response{
+				StatusCode: http.StatusBadGateway,
+				Body:       ioutil.NopCloser(bytes.NewBuffer([]byte("<html>Bad Gateway</html>"))),
+				Header:     make(http.Header),
+			}, nil
+		}
+
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBuffer([]byte(usersCancelJSON))),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	u, _ := url.Parse(sandboxBaseURL)
+	users := Users{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}, retryPolicy: &RetryPolicy{MaxAttempts: 3}}
+
+	_, err := users.Cancel(context.Background(), &CancelUserOptions{42})
+	ok(t, err)
+	equals(t, 3, attempts)
+	equals(t, bodies[0], bodies[2])
+}
+
+func TestRetryGivesUpAfterMaxAttempts(t *testing.T) {
+	attempts := 0
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		attempts++
+		return nil, errors.New("connection reset by peer")
+	})
+
+	u, _ := url.Parse(sandboxBaseURL)
+	users := Users{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}, retryPolicy: &RetryPolicy{MaxAttempts: 2}}
+
+	_, err := users.Cancel(context.Background(), &CancelUserOptions{42})
+	errorred(t, err, "connection reset by peer")
+	equals(t, 2, attempts)
+}
+
+func TestRetryIsDisabledForNonIdempotentRequests(t *testing.T) {
+	attempts := 0
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		attempts++
+		return nil, errors.New("connection reset by peer")
+	})
+
+	u, _ := url.Parse(sandboxBaseURL)
+	charges := Charges{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}, retryPolicy: &RetryPolicy{MaxAttempts: 3}}
+
+	_, _, err := charges.Charge(context.Background(), 42, &ChargeOptions{Amount: "10.00", ChargeName: "Extra seats"})
+	errorred(t, err, "connection reset by peer")
+	equals(t, 1, attempts)
+
+	attempts = 0
+	charges.retryPolicy = &RetryPolicy{MaxAttempts: 3, RetryNonIdempotent: true}
+	_, _, err = charges.Charge(context.Background(), 42, &ChargeOptions{Amount: "10.00", ChargeName: "Extra seats"})
+	errorred(t, err, "connection reset by peer")
+	equals(t, 3, attempts)
+}
+
+func TestRetryStopsOnCancelledContext(t *testing.T) {
+	attempts := 0
+	ctx, cancel := context.WithCancel(context.Background())
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		attempts++
+		cancel()
+		return nil, errors.New("connection reset by peer")
+	})
+
+	u, _ := url.Parse(sandboxBaseURL)
+	users := Users{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}, retryPolicy: &RetryPolicy{MaxAttempts: 3, MinBackoff: time.Second}}
+
+	_, err := users.Cancel(ctx, &CancelUserOptions{42})
+	errorred(t, err, "connection reset by peer")
+	equals(t, 1, attempts)
+}
+
+func TestRetryBackoff(t *testing.T) {
+	policy := &RetryPolicy{MinBackoff: 100 * time.Millisecond, MaxBackoff: 300 * time.Millisecond}
+
+	for attempt, max := range map[int]time.Duration{1: 100 * time.Millisecond, 2: 200 * time.Millisecond, 5: 300 * time.Millisecond} {
+		delay := policy.backoff(attempt, nil)
+		if delay < max/2 || delay > max {
+			t.Fatalf("backoff for attempt %d is out of range: %s", attempt, delay)
+		}
+	}
+
+	header := make(http.Header)
+	header.Set("Retry-After", "7")
+	equals(t, 7*time.Second, policy.backoff(1, &http.Response{Header: header}))
+}
+
+func TestWithRetryPolicyValidation(t *testing.T) {
+	_, err := NewClient(Authentication{VendorID: 42, VendorAuthCode: "abc"}, WithRetryPolicy(&RetryPolicy{}))
+	errorred(t, err, "at least one attempt")
+
+	client, err := NewClient(Authentication{VendorID: 42, VendorAuthCode: "abc"}, WithRetryPolicy(DefaultRetryPolicy()))
+	ok(t, err)
+	equals(t, DefaultRetryPolicy().MaxAttempts, client.Modifiers.retryPolicy.MaxAttempts)
+}
@@ -0,0 +1,116 @@
+package paddle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// Transactions is an API to work with the Paddle transactions.
+type Transactions api
+
+// TransactionEntity represents the entity type the transactions are listed for.
+type TransactionEntity string
+
+const (
+	// TransactionEntityUser lists transactions of the user.
+	TransactionEntityUser TransactionEntity = "user"
+	// TransactionEntitySubscription lists transactions of the subscription.
+	TransactionEntitySubscription TransactionEntity = "subscription"
+	// TransactionEntityOrder lists transactions of the order.
+	TransactionEntityOrder TransactionEntity = "order"
+	// TransactionEntityCheckout lists transactions of the checkout.
+	TransactionEntityCheckout TransactionEntity = "checkout"
+	// TransactionEntityProduct lists transactions of the product.
+	TransactionEntityProduct TransactionEntity = "product"
+)
+
+// Transaction represents a Paddle transaction.
+type Transaction struct {
+	OrderID        string                   `json:"order_id,omitempty"`
+	CheckoutID     string                   `json:"checkout_id,omitempty"`
+	Amount         string                   `json:"amount,omitempty"`
+	Currency       string                   `json:"currency,omitempty"`
+	Status         string                   `json:"status,omitempty"`
+	CreatedAt      string                   `json:"created_at,omitempty"`
+	Passthrough    string                   `json:"passthrough,omitempty"`
+	ProductID      uint64                   `json:"product_id,omitempty"`
+	IsSubscription bool                     `json:"is_subscription,omitempty"`
+	IsOneOff       bool                     `json:"is_one_off,omitempty"`
+	Subscription   *TransactionSubscription `json:"subscription,omitempty"`
+	User           *TransactionUser         `json:"user,omitempty"`
+	ReceiptURL     string                   `json:"receipt_url,omitempty"`
+}
+
+// TransactionSubscription represents the subscription of a transaction.
+type TransactionSubscription struct {
+	SubscriptionID uint64 `json:"subscription_id,omitempty"`
+	Status         string `json:"status,omitempty"`
+}
+
+// TransactionUser represents the user of a transaction.
+type TransactionUser struct {
+	UserID           uint64 `json:"user_id,omitempty"`
+	Email            string `json:"email,omitempty"`
+	MarketingConsent bool   `json:"marketing_consent,omitempty"`
+}
+
+// listTransactionsOptions represents options for listing transactions.
+type listTransactionsOptions struct {
+	page int
+}
+
+// encodeURLValues encodes options as URL parameters.
+func (options *listTransactionsOptions) encodeURLValues() (url.Values, error) {
+	values := make(url.Values)
+	if options.page > 0 {
+		values.Set("page", strconv.Itoa(options.page))
+	}
+
+	return values, nil
+}
+
+// List returns a page of transactions of the entity, the first page is returned if page is zero.
+//
+// Paddle docs: https://developer.paddle.com/api-reference/product-api/transactions/listtransactions
+func (transactions *Transactions) List(ctx context.Context, entity TransactionEntity, id string, page int) ([]*Transaction, *http.Response, error) {
+	switch entity {
+	case TransactionEntityUser, TransactionEntitySubscription, TransactionEntityOrder, TransactionEntityCheckout, TransactionEntityProduct:
+	default:
+		return nil, nil, errors.New("entity must be one of \"user\", \"subscription\", \"order\", \"checkout\", \"product\"")
+	}
+	if id == "" {
+		return nil, nil, errors.New("entity ID is required")
+	}
+
+	path := fmt.Sprintf("2.0/%s/%s/transactions", entity, url.PathEscape(id))
+
+	request, err := newRequest(ctx, http.MethodPost, transactions.baseURL, path, transactions.authentication, &listTransactionsOptions{page: page})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create new request: %w", err)
+	}
+
+	response := new(response[[]*Transaction])
+	httpResponse, err := doRequest((*api)(transactions), request, response)
+	if err != nil {
+		return nil, httpResponse, err
+	}
+
+	return response.value(), httpResponse, nil
+}
+
+// Iter returns an iterator over all transactions of the entity, pages are fetched lazily
+// until an empty page is returned.
+func (transactions *Transactions) Iter(ctx context.Context, entity TransactionEntity, id string, iteratorOptions ...IteratorOption) *Iterator[*Transaction] {
+	return newIterator(ctx, 1, func(ctx context.Context, page int) ([]*Transaction, error) {
+		result, _, err := transactions.List(ctx, entity, id, page)
+		if err != nil {
+			return nil, err
+		}
+
+		return result, nil
+	}, iteratorOptions)
+}
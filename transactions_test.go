@@ -0,0 +1,143 @@
+package paddle
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestTransactionsListOnValidationError(t *testing.T) {
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		return nil, nil
+	})
+	u, _ := url.Parse(sandboxBaseURL)
+	transactions := Transactions{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}}
+
+	_, _, err := transactions.List(context.Background(), "invoice", "42", 0)
+	errorred(t, err, "entity must be one of")
+
+	_, _, err = transactions.List(context.Background(), TransactionEntityUser, "", 0)
+	errorred(t, err, "entity ID is required")
+}
+
+func TestTransactionsListOnSuccess(t *testing.T) {
+	var path string
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		path = req.URL.Path
+
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBuffer([]byte(transactionsListJSON))),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	u, _ := url.Parse(sandboxBaseURL)
+	transactions := Transactions{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}}
+
+	result, _, err := transactions.List(context.Background(), TransactionEntitySubscription, "502198", 0)
+	ok(t, err)
+
+	equals(t, "/api/2.0/subscription/502198/transactions", path)
+	equals(t, &Transaction{
+		OrderID:        "1042907-384786",
+		CheckoutID:     "7814479-chre0e6f7ff6b4d-34f2e6e1b0",
+		Amount:         "5.00",
+		Currency:       "USD",
+		Status:         "completed",
+		CreatedAt:      "2022-01-26 13:38:20",
+		ProductID:      12345,
+		IsSubscription: true,
+		Subscription:   &TransactionSubscription{SubscriptionID: 502198, Status: "active"},
+		User:           &TransactionUser{UserID: 29777, Email: "qa@screenshotone.com", MarketingConsent: true},
+		ReceiptURL:     "https://sandbox-my.paddle.com/receipt/1042907-384786/7814479-chre0e6f7ff6b4d-34f2e6e1b0",
+	}, result[0])
+}
+
+func TestTransactionsIterWalksPages(t *testing.T) {
+	var pages []string
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		ok(t, req.ParseForm())
+		page := req.PostForm.Get("page")
+		pages = append(pages, page)
+
+		body := transactionsListJSON
+		if page == "2" {
+			body = usersListEmptyJSON
+		}
+
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBuffer([]byte(body))),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	u, _ := url.Parse(sandboxBaseURL)
+	transactions := Transactions{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}}
+
+	iterator := transactions.Iter(context.Background(), TransactionEntityUser, "29777")
+	defer iterator.Close()
+
+	var orderIDs []string
+	for iterator.Next() {
+		orderIDs = append(orderIDs, iterator.Value().OrderID)
+	}
+	ok(t, iterator.Err())
+
+	equals(t, []string{"1042907-384786", "1042908-384787"}, orderIDs)
+	equals(t, []string{"1", "2"}, pages)
+}
+
+const transactionsListJSON = `{
+    "success": true,
+    "response": [
+        {
+            "order_id": "1042907-384786",
+            "checkout_id": "7814479-chre0e6f7ff6b4d-34f2e6e1b0",
+            "amount": "5.00",
+            "currency": "USD",
+            "status": "completed",
+            "created_at": "2022-01-26 13:38:20",
+            "passthrough": null,
+            "product_id": 12345,
+            "is_subscription": true,
+            "is_one_off": false,
+            "subscription": {
+                "subscription_id": 502198,
+                "status": "active"
+            },
+            "user": {
+                "user_id": 29777,
+                "email": "qa@screenshotone.com",
+                "marketing_consent": true
+            },
+            "receipt_url": "https://sandbox-my.paddle.com/receipt/1042907-384786/7814479-chre0e6f7ff6b4d-34f2e6e1b0"
+        },
+        {
+            "order_id": "1042908-384787",
+            "checkout_id": "7814480-chre0e6f7ff6b4d-34f2e6e1b1",
+            "amount": "5.00",
+            "currency": "USD",
+            "status": "refunded",
+            "created_at": "2022-02-26 13:38:20",
+            "passthrough": "{\"account_id\":42}",
+            "product_id": 12345,
+            "is_subscription": true,
+            "is_one_off": false,
+            "subscription": {
+                "subscription_id": 502198,
+                "status": "active"
+            },
+            "user": {
+                "user_id": 29777,
+                "email": "qa@screenshotone.com",
+                "marketing_consent": true
+            },
+            "receipt_url": "https://sandbox-my.paddle.com/receipt/1042908-384787/7814480-chre0e6f7ff6b4d-34f2e6e1b1"
+        }
+    ]
+}`
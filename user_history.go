@@ -0,0 +1,179 @@
+package paddle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// UserHistory is an API to work with the Paddle user history.
+type UserHistory api
+
+// GetUserHistoryOptions represents options for getting the user history.
+type GetUserHistoryOptions struct {
+	Email     string
+	ProductID uint64
+}
+
+// encodeURLValues encodes options as URL parameters.
+func (options *GetUserHistoryOptions) encodeURLValues() (url.Values, error) {
+	values := make(url.Values)
+	if options.Email == "" {
+		return nil, errors.New("\"email\" is required")
+	}
+
+	values.Set("email", options.Email)
+	if options.ProductID != 0 {
+		values.Set("product_id", strconv.FormatUint(options.ProductID, 10))
+	}
+
+	return values, nil
+}
+
+// userHistoryOptions adds the vendor ID to the user history options,
+// the vendor auth code is not sent to the public checkout API.
+type userHistoryOptions struct {
+	*GetUserHistoryOptions
+	vendorID int
+}
+
+// encodeURLValues encodes options as URL parameters.
+func (options *userHistoryOptions) encodeURLValues() (url.Values, error) {
+	values, err := options.GetUserHistoryOptions.encodeURLValues()
+	if err != nil {
+		return nil, err
+	}
+
+	values.Set(vendorID, strconv.Itoa(options.vendorID))
+
+	return values, nil
+}
+
+// UserHistoryResponse represents a response for the user history request.
+type UserHistoryResponse struct {
+	Message string `json:"message,omitempty"`
+}
+
+// Get asks Paddle to email the user the history of their transactions, licenses and downloads
+// for the vendor and the product, if specified. The endpoint only triggers the email,
+// the response contains the confirmation message and no history data.
+//
+// Paddle docs: https://developer.paddle.com/api-reference/checkout-api/user-history/getuserhistory
+func (history *UserHistory) Get(ctx context.Context, options *GetUserHistoryOptions) (*UserHistoryResponse, *http.Response, error) {
+	path := "2.0/user/history"
+
+	if options == nil {
+		options = new(GetUserHistoryOptions)
+	}
+	if history.authentication == nil {
+		return nil, nil, errors.New("vendor ID is required")
+	}
+	request, err := newRequest(ctx, http.MethodGet, history.baseURL, path, nil, &userHistoryOptions{options, history.authentication.VendorID})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create new request: %w", err)
+	}
+
+	response := new(response[*UserHistoryResponse])
+	httpResponse, err := doRequest((*api)(history), request, response)
+	if err != nil {
+		return nil, httpResponse, err
+	}
+
+	return response.value(), httpResponse, nil
+}
+
+// TimelineEventType represents the type of the user timeline event.
+type TimelineEventType string
+
+const (
+	// TimelineSignup represents the subscription sign up.
+	TimelineSignup TimelineEventType = "signup"
+	// TimelinePayment represents the last subscription payment.
+	TimelinePayment TimelineEventType = "payment"
+	// TimelinePaused represents the subscription pause.
+	TimelinePaused TimelineEventType = "paused"
+	// TimelineNextPayment represents the upcoming subscription payment.
+	TimelineNextPayment TimelineEventType = "next_payment"
+)
+
+// TimelineEvent represents an event in the user timeline.
+type TimelineEvent struct {
+	Time           time.Time
+	Type           TimelineEventType
+	SubscriptionID int
+	PlanID         int
+	// Payment is set for the payment events.
+	Payment *UserPayment
+}
+
+// UserTimeline represents the audit trail of the user subscriptions.
+type UserTimeline struct {
+	Email string
+	// Subscriptions are the subscriptions of the user.
+	Subscriptions []*User
+	// Events are ordered by time.
+	Events []*TimelineEvent
+}
+
+// BuildUserTimeline builds one timeline for the user with the email from the subscription users,
+// e.g. returned by Users.List or Users.ListAll. Subscriptions of other users are skipped.
+// The timeline contains only the events that can be derived from the subscription users,
+// since UserHistory.Get emails the history to the user and does not return it.
+func BuildUserTimeline(email string, users []*User) *UserTimeline {
+	timeline := &UserTimeline{Email: email}
+
+	for _, user := range users {
+		if user == nil || !strings.EqualFold(user.UserEmail, email) {
+			continue
+		}
+
+		timeline.Subscriptions = append(timeline.Subscriptions, user)
+		timeline.addEvent(user, TimelineSignup, user.SignupDate, nil)
+		timeline.addEvent(user, TimelinePaused, user.PausedAt, nil)
+		if user.LastPayment != nil {
+			timeline.addEvent(user, TimelinePayment, user.LastPayment.Date, user.LastPayment)
+		}
+		if user.NextPayment != nil {
+			timeline.addEvent(user, TimelineNextPayment, user.NextPayment.Date, user.NextPayment)
+		}
+	}
+
+	sort.SliceStable(timeline.Events, func(i, j int) bool {
+		return timeline.Events[i].Time.Before(timeline.Events[j].Time)
+	})
+
+	return timeline
+}
+
+// addEvent adds the event if its date is set and valid.
+func (timeline *UserTimeline) addEvent(user *User, eventType TimelineEventType, date string, payment *UserPayment) {
+	t, ok := parseDate(date)
+	if !ok {
+		return
+	}
+
+	timeline.Events = append(timeline.Events, &TimelineEvent{
+		Time:           t,
+		Type:           eventType,
+		SubscriptionID: user.SubscriptionID,
+		PlanID:         user.PlanID,
+		Payment:        payment,
+	})
+}
+
+// parseDate parses dates in the formats used by the Paddle API.
+func parseDate(value string) (time.Time, bool) {
+	for _, layout := range []string{"2006-01-02 15:04:05", "2006-01-02"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, true
+		}
+	}
+
+	return time.Time{}, false
+}
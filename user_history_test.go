@@ -0,0 +1,87 @@
+package paddle
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestUserHistoryGetOnValidationError(t *testing.T) {
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		return nil, nil
+	})
+	u, _ := url.Parse(sandboxCheckoutBaseURL)
+	history := UserHistory{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}}
+
+	_, _, err := history.Get(context.Background(), nil)
+	errorred(t, err, "\"email\" is required")
+}
+
+func TestUserHistoryGetOnSuccess(t *testing.T) {
+	var request *http.Request
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		request = req
+
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBuffer([]byte(userHistoryJSON))),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	u, _ := url.Parse(sandboxCheckoutBaseURL)
+	history := UserHistory{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}}
+
+	result, _, err := history.Get(context.Background(), &GetUserHistoryOptions{Email: "qa@screenshotone.com", ProductID: 26100})
+	ok(t, err)
+
+	equals(t, http.MethodGet, request.Method)
+	equals(t, "https://sandbox-checkout.paddle.com/api/2.0/user/history?email=qa%40screenshotone.com&product_id=26100&vendor_id=42", request.URL.String())
+	equals(t, &UserHistoryResponse{Message: "We've sent details of your past transactions, licenses and downloads to you via email."}, result)
+}
+
+func TestNewClientUserHistoryUsesCheckoutBaseURL(t *testing.T) {
+	client, err := NewClient(Authentication{VendorID: 42, VendorAuthCode: "abc"}, WithEnvironment(EnvironmentSandbox))
+	ok(t, err)
+
+	equals(t, sandboxCheckoutBaseURL, client.UserHistory.baseURL.String())
+	equals(t, 42, client.UserHistory.authentication.VendorID)
+}
+
+func TestBuildUserTimeline(t *testing.T) {
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBuffer([]byte(usersListJSON))),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	u, _ := url.Parse(sandboxBaseURL)
+	users := Users{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}}
+
+	subscriptions, _, err := users.List(context.Background(), nil)
+	ok(t, err)
+	subscriptions = append(subscriptions, &User{SubscriptionID: 1, UserEmail: "someone@example.com", SignupDate: "2022-01-01 00:00:00"})
+
+	timeline := BuildUserTimeline("QA@screenshotone.com", subscriptions)
+
+	equals(t, 2, len(timeline.Subscriptions))
+	equals(t, 6, len(timeline.Events))
+	equals(t, &TimelineEvent{Time: time.Date(2022, 3, 30, 16, 6, 5, 0, time.UTC), Type: TimelineSignup, SubscriptionID: 232564, PlanID: 26100}, timeline.Events[0])
+	equals(t, TimelineSignup, timeline.Events[1].Type)
+	equals(t, TimelinePayment, timeline.Events[2].Type)
+	equals(t, TimelineNextPayment, timeline.Events[5].Type)
+	equals(t, &UserPayment{7, "USD", "2022-05-30"}, timeline.Events[5].Payment)
+}
+
+const userHistoryJSON = `{
+    "success": true,
+    "response": {
+        "message": "We've sent details of your past transactions, licenses and downloads to you via email."
+    }
+}`
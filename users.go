@@ -102,21 +102,55 @@ func (users *Users) List(ctx context.Context, options *ListUsersOptions) ([]*Use
 	}
 
 	response := new(response[[]*User])
-	httpResponse, err := doRequest(users.httpClient, request, response)
+	httpResponse, err := doRequest((*api)(users), request, response)
 	if err != nil {
 		return nil, httpResponse, err
 	}
 
-	return *response.Response, httpResponse, nil
+	return response.value(), httpResponse, nil
+}
+
+// Iter returns an iterator over all subscription users matching the options, pages are fetched lazily
+// starting with options.Page until an empty page is returned.
+func (users *Users) Iter(ctx context.Context, options *ListUsersOptions, iteratorOptions ...IteratorOption) *Iterator[*User] {
+	var pageOptions ListUsersOptions
+	if options != nil {
+		pageOptions = *options
+	}
+
+	return newIterator(ctx, pageOptions.Page, func(ctx context.Context, page int) ([]*User, error) {
+		pageOptions := pageOptions
+		pageOptions.Page = page
+
+		result, _, err := users.List(ctx, &pageOptions)
+		if err != nil {
+			return nil, err
+		}
+
+		return result, nil
+	}, iteratorOptions)
+}
+
+// ListAll returns all subscription users matching the options, walking through all pages.
+func (users *Users) ListAll(ctx context.Context, options *ListUsersOptions) ([]*User, error) {
+	return collect(users.Iter(ctx, options))
 }
 
 // UpdateUsersOptions represents options for update user subscription.
+// Optional boolean options are sent only when they are set explicitly, e.g. with Bool(false).
 type UpdateUserOptions struct {
 	SubscriptionID  uint64
 	PlanID          uint64
-	Prorate         bool
-	BillImmediately bool
-	KeepModifiers   bool
+	Prorate         *bool
+	BillImmediately *bool
+	KeepModifiers   *bool
+	Quantity        int
+	// Currency is required when RecurringPrice is set.
+	Currency       string
+	RecurringPrice string
+	Passthrough    string
+	// Pause pauses the subscription if true, and resumes the paused subscription if false.
+	Pause *bool
 }
 
 // encodeURLValues encodes options as URL parameters.
@@ -131,9 +165,28 @@ func (options *UpdateUserOptions) encodeURLValues() (url.Values, error) {
 	if options.PlanID != 0 {
 		values.Set("plan_id", strconv.FormatUint(options.PlanID, 10))
 	}
-	values.Set("prorate", strconv.FormatBool(options.Prorate))
-	values.Set("bill_immediately", strconv.FormatBool(options.BillImmediately))
-	values.Set("keep_modifiers", strconv.FormatBool(options.KeepModifiers))
+	setBool(values, "prorate", options.Prorate)
+	setBool(values, "bill_immediately", options.BillImmediately)
+	setBool(values, "keep_modifiers", options.KeepModifiers)
+
+	if options.Quantity < 0 {
+		return nil, errors.New("\"quantity\" can't be negative")
+	} else if options.Quantity > 0 {
+		values.Set("quantity", strconv.Itoa(options.Quantity))
+	}
+
+	if options.RecurringPrice != "" {
+		if options.Currency == "" {
+			return nil, errors.New("\"currency\" is required when \"recurring_price\" is set")
+		}
+		if err := validateAmount(options.RecurringPrice); err != nil {
+			return nil, fmt.Errorf("\"recurring_price\" %w", err)
+		}
+		values.Set("recurring_price", options.RecurringPrice)
+	}
+	setString(values, "currency", options.Currency)
+	setString(values, "passthrough", options.Passthrough)
+	setBool(values, "pause", options.Pause)
 
 	return values, nil
 }
@@ -161,12 +214,26 @@ func (users *Users) Update(ctx context.Context, options *UpdateUserOptions) (*Up
 	}
 
 	response := new(response[*UpdateUserResponse])
-	httpResponse, err := doRequest(users.httpClient, request, response)
+	httpResponse, err := doRequest((*api)(users), request, response)
 	if err != nil {
 		return nil, httpResponse, err
 	}
 
-	return *response.Response, httpResponse, nil
+	return response.value(), httpResponse, nil
+}
+
+// Pause pauses the user subscription, payments are stopped at the end of the current billing period.
+//
+// Paddle docs: https://developer.paddle.com/api-reference/b3A6MzA3NDQ3MzQ-update-user
+func (users *Users) Pause(ctx context.Context, subscriptionID uint64) (*UpdateUserResponse, *http.Response, error) {
+	return users.Update(ctx, &UpdateUserOptions{SubscriptionID: subscriptionID, Pause: Bool(true)})
+}
+
+// Resume resumes the paused user subscription.
+//
+// Paddle docs: https://developer.paddle.com/api-reference/b3A6MzA3NDQ3MzQ-update-user
+func (users *Users) Resume(ctx context.Context, subscriptionID uint64) (*UpdateUserResponse, *http.Response, error) {
+	return users.Update(ctx, &UpdateUserOptions{SubscriptionID: subscriptionID, Pause: Bool(false)})
 }
 
 // CancelUserOptions represents options for cancel user subscription.
@@ -200,7 +267,7 @@ func (users *Users) Cancel(ctx context.Context, options *CancelUserOptions) (*ht
 		return nil, fmt.Errorf("failed to create new request: %w", err)
 	}
 
-	httpResponse, err := doRequest(users.httpClient, request, new(response[interface{}]))
+	httpResponse, err := doRequest((*api)(users), request, new(response[interface{}]))
 	if err != nil {
 		return httpResponse, err
 	}
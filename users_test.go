@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"sync"
 	"testing"
 )
 
@@ -22,7 +23,7 @@ func TestUsersUpdateOnAPIError(t *testing.T) {
 	u, _ := url.Parse(sandboxBaseURL)
 	users := Users{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}}
 
-	_, _, err := users.Update(context.Background(), &UpdateUserOptions{42, 42, true, true, true})
+	_, _, err := users.Update(context.Background(), &UpdateUserOptions{SubscriptionID: 42, PlanID: 42, Prorate: Bool(true), BillImmediately: Bool(true), KeepModifiers: Bool(true)})
 	equals(t, err, &APIError{102, "Bad api key"})
 }
 
@@ -50,13 +51,87 @@ func TestUsersUpdateOnSuccess(t *testing.T) {
 	u, _ := url.Parse(sandboxBaseURL)
 	users := Users{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}}
 
-	result, actualResponse, err := users.Update(context.Background(), &UpdateUserOptions{42, 42, true, true, false})
+	result, actualResponse, err := users.Update(context.Background(), &UpdateUserOptions{SubscriptionID: 42, PlanID: 42, Prorate: Bool(true), BillImmediately: Bool(true), KeepModifiers: Bool(false)})
 
 	ok(t, err)
 	equals(t, expectedResponse, actualResponse)
 	equals(t, &UpdateUserResponse{12345, 525123, 425123, &UserPayment{144.06, "GBP", "2018-02-15"}}, result)
 }
 
+func TestUsersUpdateSendsOnlyExplicitOptions(t *testing.T) {
+	var form url.Values
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		ok(t, req.ParseForm())
+		form = req.PostForm
+
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBuffer([]byte(usersUpdateJSON))),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	u, _ := url.Parse(sandboxBaseURL)
+	users := Users{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}}
+
+	_, _, err := users.Update(context.Background(), &UpdateUserOptions{
+		SubscriptionID:  12345,
+		Quantity:        5,
+		Currency:        "USD",
+		RecurringPrice:  "35.00",
+		Passthrough:     `{"seats":5}`,
+		BillImmediately: Bool(false),
+	})
+	ok(t, err)
+
+	equals(t, "5", form.Get("quantity"))
+	equals(t, "USD", form.Get("currency"))
+	equals(t, "35.00", form.Get("recurring_price"))
+	equals(t, `{"seats":5}`, form.Get("passthrough"))
+	equals(t, "false", form.Get("bill_immediately"))
+	equals(t, false, form.Has("prorate"))
+	equals(t, false, form.Has("keep_modifiers"))
+	equals(t, false, form.Has("pause"))
+}
+
+func TestUsersUpdateOnPriceValidationError(t *testing.T) {
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		return nil, nil
+	})
+	u, _ := url.Parse(sandboxBaseURL)
+	users := Users{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}}
+
+	_, _, err := users.Update(context.Background(), &UpdateUserOptions{SubscriptionID: 42, RecurringPrice: "35.00"})
+	errorred(t, err, "\"currency\" is required when \"recurring_price\" is set")
+
+	_, _, err = users.Update(context.Background(), &UpdateUserOptions{SubscriptionID: 42, Quantity: -1})
+	errorred(t, err, "\"quantity\" can't be negative")
+}
+
+func TestUsersPauseAndResume(t *testing.T) {
+	var pause []string
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		ok(t, req.ParseForm())
+		pause = append(pause, req.PostForm.Get("pause"))
+
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBuffer([]byte(usersUpdateJSON))),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	u, _ := url.Parse(sandboxBaseURL)
+	users := Users{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}}
+
+	_, _, err := users.Pause(context.Background(), 12345)
+	ok(t, err)
+	_, _, err = users.Resume(context.Background(), 12345)
+	ok(t, err)
+
+	equals(t, []string{"true", "false"}, pause)
+}
+
 func TestUsersCancelOnAPIError(t *testing.T) {
 	expectedResponse := &http.Response{
 		StatusCode: 200,
@@ -173,6 +248,133 @@ func TestUsersListOnSuccess(t *testing.T) {
 	equals(t, 2, len(result))
 }
 
+func TestUsersListAllWalksPages(t *testing.T) {
+	var pages []string
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		ok(t, req.ParseForm())
+		page := req.PostForm.Get("page")
+		pages = append(pages, page)
+
+		body := usersListJSON
+		if page == "3" {
+			body = usersListEmptyJSON
+		}
+
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBuffer([]byte(body))),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	u, _ := url.Parse(sandboxBaseURL)
+	users := Users{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}}
+
+	result, err := users.ListAll(context.Background(), &ListUsersOptions{PlanID: 26100})
+	ok(t, err)
+
+	equals(t, 4, len(result))
+	equals(t, []string{"1", "2", "3"}, pages)
+}
+
+func TestUsersIterStopsEarly(t *testing.T) {
+	requests := 0
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		requests++
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBuffer([]byte(usersListJSON))),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	u, _ := url.Parse(sandboxBaseURL)
+	users := Users{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}}
+
+	iterator := users.Iter(context.Background(), nil)
+	equals(t, true, iterator.Next())
+	equals(t, 232564, iterator.Value().SubscriptionID)
+	iterator.Close()
+
+	equals(t, false, iterator.Next())
+	ok(t, iterator.Err())
+	equals(t, 1, requests)
+}
+
+func TestUsersIterWithPrefetch(t *testing.T) {
+	var mu sync.Mutex
+	var pages []string
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		_ = req.ParseForm()
+		page := req.PostForm.Get("page")
+		mu.Lock()
+		pages = append(pages, page)
+		mu.Unlock()
+
+		body := usersListJSON
+		if page == "4" {
+			body = usersListEmptyJSON
+		}
+
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBuffer([]byte(body))),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	u, _ := url.Parse(sandboxBaseURL)
+	users := Users{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}}
+
+	iterator := users.Iter(context.Background(), &ListUsersOptions{Page: 2}, WithPrefetch())
+	defer iterator.Close()
+
+	count := 0
+	for iterator.Next() {
+		count++
+	}
+	ok(t, iterator.Err())
+
+	equals(t, 4, count)
+	equals(t, []string{"2", "3", "4"}, pages)
+}
+
+func TestUsersIterOnError(t *testing.T) {
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBuffer([]byte(usersListErrorJSON))),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	u, _ := url.Parse(sandboxBaseURL)
+	users := Users{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}}
+
+	_, err := users.ListAll(context.Background(), nil)
+	equals(t, &APIError{102, "Bad api key"}, err)
+}
+
+func TestUsersIterOnCancelledContext(t *testing.T) {
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		return nil, nil
+	})
+
+	u, _ := url.Parse(sandboxBaseURL)
+	users := Users{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := users.ListAll(ctx, nil)
+	equals(t, context.Canceled, err)
+}
+
+const usersListEmptyJSON = `{
+    "success": true,
+    "response": []
+}`
+
 const usersListErrorJSON = `{
     "success": false,
     "error": {
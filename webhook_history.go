@@ -0,0 +1,175 @@
+package paddle
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// WebhookHistory is an API to work with the history of the webhook alerts sent by Paddle.
+type WebhookHistory api
+
+// GetWebhookHistoryOptions represents options for getting the webhook history.
+type GetWebhookHistoryOptions struct {
+	Page          int
+	AlertsPerPage int
+	// QueryHead is the start of the date range.
+	QueryHead time.Time
+	// QueryTail is the end of the date range.
+	QueryTail time.Time
+	// AlertNames keeps only alerts with the specified names, the filter is applied to the returned page.
+	AlertNames []string
+}
+
+// encodeURLValues encodes options as URL parameters.
+func (options *GetWebhookHistoryOptions) encodeURLValues() (url.Values, error) {
+	values := make(url.Values)
+	if options.Page < 0 {
+		return nil, errors.New("\"page\" can't be negative")
+	} else if options.Page > 0 {
+		values.Set("page", strconv.Itoa(options.Page))
+	}
+	if options.AlertsPerPage < 0 {
+		return nil, errors.New("\"alerts_per_page\" can't be negative")
+	} else if options.AlertsPerPage > 0 {
+		values.Set("alerts_per_page", strconv.Itoa(options.AlertsPerPage))
+	}
+	if !options.QueryHead.IsZero() && !options.QueryTail.IsZero() && options.QueryTail.Before(options.QueryHead) {
+		return nil, errors.New("\"query_tail\" can't be before \"query_head\"")
+	}
+	if !options.QueryHead.IsZero() {
+		values.Set("query_head", options.QueryHead.Format("2006-01-02 15:04:05"))
+	}
+	if !options.QueryTail.IsZero() {
+		values.Set("query_tail", options.QueryTail.Format("2006-01-02 15:04:05"))
+	}
+
+	return values, nil
+}
+
+// keep reports whether the alert passes the alert names filter.
+func (options *GetWebhookHistoryOptions) keep(alert *HistoryAlert) bool {
+	if len(options.AlertNames) == 0 {
+		return true
+	}
+
+	for _, name := range options.AlertNames {
+		if name == alert.AlertName {
+			return true
+		}
+	}
+
+	return false
+}
+
+// WebhookHistoryResponse represents a page of the webhook history.
+type WebhookHistoryResponse struct {
+	CurrentPage   int             `json:"current_page,omitempty"`
+	TotalPages    int             `json:"total_pages,omitempty"`
+	AlertsPerPage int             `json:"alerts_per_page,omitempty"`
+	TotalAlerts   int             `json:"total_alerts,omitempty"`
+	QueryHead     string          `json:"query_head,omitempty"`
+	Data          []*HistoryAlert `json:"data,omitempty"`
+}
+
+// HistoryAlert represents a webhook alert Paddle attempted to deliver.
+type HistoryAlert struct {
+	ID        uint64 `json:"id,omitempty"`
+	AlertName string `json:"alert_name,omitempty"`
+	Status    string `json:"status,omitempty"`
+	CreatedAt string `json:"created_at,omitempty"`
+	UpdatedAt string `json:"updated_at,omitempty"`
+	Attempts  int    `json:"attempts,omitempty"`
+	// Fields is the raw alert payload.
+	Fields map[string]json.RawMessage `json:"fields,omitempty"`
+}
+
+// Values returns the alert payload as form values, the same way they are posted to the webhook endpoint.
+func (alert *HistoryAlert) Values() (url.Values, error) {
+	values := make(url.Values, len(alert.Fields)+2)
+	for key, raw := range alert.Fields {
+		var value interface{}
+		decoder := json.NewDecoder(bytes.NewReader(raw))
+		decoder.UseNumber()
+		if err := decoder.Decode(&value); err != nil {
+			return nil, fmt.Errorf("failed to decode field %s: %w", key, err)
+		}
+
+		switch v := value.(type) {
+		case nil:
+			continue
+		case string:
+			values.Set(key, v)
+		case json.Number:
+			values.Set(key, v.String())
+		case bool:
+			if v {
+				values.Set(key, "1")
+			} else {
+				values.Set(key, "0")
+			}
+		default:
+			values.Set(key, string(raw))
+		}
+	}
+
+	if values.Get("alert_name") == "" {
+		values.Set("alert_name", alert.AlertName)
+	}
+	if values.Get("alert_id") == "" {
+		values.Set("alert_id", strconv.FormatUint(alert.ID, 10))
+	}
+
+	return values, nil
+}
+
+// Get returns a page of the webhook alerts sent by Paddle.
+//
+// Paddle docs: https://developer.paddle.com/api-reference/alert-api/webhooks/webhooks
+func (history *WebhookHistory) Get(ctx context.Context, options *GetWebhookHistoryOptions) (*WebhookHistoryResponse, *http.Response, error) {
+	path := "2.0/alert/webhooks"
+
+	if options == nil {
+		options = new(GetWebhookHistoryOptions)
+	}
+	request, err := newRequest(ctx, http.MethodPost, history.baseURL, path, history.authentication, options)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create new request: %w", err)
+	}
+
+	response := new(response[*WebhookHistoryResponse])
+	httpResponse, err := doRequest((*api)(history), request, response)
+	if err != nil {
+		return nil, httpResponse, err
+	}
+
+	result := response.value()
+	if result != nil {
+		var alerts []*HistoryAlert
+		for _, alert := range result.Data {
+			if options.keep(alert) {
+				alerts = append(alerts, alert)
+			}
+		}
+		result.Data = alerts
+	}
+
+	return result, httpResponse, nil
+}
+
+// ParseHistoryAlert returns a typed alert for the alert from the webhook history, like ParseRequest does.
+// The signature is not verified since the history is fetched from the authenticated API.
+func (webhooks *Webhooks) ParseHistoryAlert(alert *HistoryAlert) (interface{}, error) {
+	values, err := alert.Values()
+	if err != nil {
+		return nil, err
+	}
+
+	return webhooks.decode(values)
+}
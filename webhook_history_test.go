@@ -0,0 +1,165 @@
+package paddle
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestWebhookHistoryGetOnValidationError(t *testing.T) {
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		return nil, nil
+	})
+	u, _ := url.Parse(sandboxBaseURL)
+	history := WebhookHistory{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}}
+
+	_, _, err := history.Get(context.Background(), &GetWebhookHistoryOptions{
+		QueryHead: time.Date(2022, 12, 31, 0, 0, 0, 0, time.UTC),
+		QueryTail: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+	})
+	errorred(t, err, "\"query_tail\" can't be before \"query_head\"")
+}
+
+func TestWebhookHistoryGetOnSuccess(t *testing.T) {
+	var form url.Values
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		ok(t, req.ParseForm())
+		form = req.PostForm
+
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBuffer([]byte(webhookHistoryJSON))),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	u, _ := url.Parse(sandboxBaseURL)
+	history := WebhookHistory{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}}
+
+	result, _, err := history.Get(context.Background(), &GetWebhookHistoryOptions{
+		Page:          2,
+		AlertsPerPage: 10,
+		QueryHead:     time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC),
+		AlertNames:    []string{"subscription_created"},
+	})
+	ok(t, err)
+
+	equals(t, "2", form.Get("page"))
+	equals(t, "10", form.Get("alerts_per_page"))
+	equals(t, "2022-06-01 00:00:00", form.Get("query_head"))
+	equals(t, false, form.Has("query_tail"))
+	equals(t, 46, result.TotalPages)
+	equals(t, 1, len(result.Data))
+	equals(t, uint64(1790992), result.Data[0].ID)
+}
+
+func TestWebhookHistoryAlertIsParsed(t *testing.T) {
+	publicKey, err := base64.StdEncoding.DecodeString(publicKeyEncodedForSubscriptionCreated)
+	if err != nil {
+		t.Fatalf("failed to parse public key: %s", err)
+		return
+	}
+
+	webhooks, err := NewWebhooks(publicKey)
+	if err != nil {
+		t.Fatalf("failed to instantiate webhooks: %s", err)
+		return
+	}
+
+	httpClient := newHTTPClient(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBuffer([]byte(webhookHistoryJSON))),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	u, _ := url.Parse(sandboxBaseURL)
+	history := WebhookHistory{httpClient: httpClient, baseURL: u, authentication: &Authentication{42, "123abc"}}
+
+	result, _, err := history.Get(context.Background(), nil)
+	ok(t, err)
+
+	alert, err := webhooks.ParseHistoryAlert(result.Data[1])
+	ok(t, err)
+
+	subscriptionCreated, isSubscriptionCreated := alert.(*SubscriptionCreatedAlert)
+	if !isSubscriptionCreated {
+		t.Fatalf("alert is not of type *SubscriptionCreatedAlert")
+		return
+	}
+	equals(t, uint64(1790992), subscriptionCreated.AlertID)
+	equals(t, uint64(264546), subscriptionCreated.SubscriptionID)
+	equals(t, true, subscriptionCreated.MarketingConsent)
+	equals(t, time.Date(2022, 7, 14, 0, 0, 0, 0, time.UTC), subscriptionCreated.NextBillDate)
+
+	alert, err = webhooks.ParseHistoryAlert(result.Data[0])
+	ok(t, err)
+
+	paymentRefunded, isPaymentRefunded := alert.(*PaymentRefundedAlert)
+	if !isPaymentRefunded {
+		t.Fatalf("alert is not of type *PaymentRefundedAlert")
+		return
+	}
+	equals(t, "1042907-384786", *paymentRefunded.OrderID)
+	equals(t, "5.00", *paymentRefunded.Amount)
+}
+
+const webhookHistoryJSON = `{
+    "success": true,
+    "response": {
+        "current_page": 2,
+        "total_pages": 46,
+        "alerts_per_page": 10,
+        "total_alerts": 460,
+        "query_head": "2022-06-01 00:00:00",
+        "data": [
+            {
+                "id": 1790991,
+                "alert_name": "payment_refunded",
+                "status": "success",
+                "created_at": "2022-06-14 13:38:20",
+                "updated_at": "2022-06-14 13:38:21",
+                "attempts": 1,
+                "fields": {
+                    "order_id": "1042907-384786",
+                    "amount": "5.00",
+                    "currency": "USD",
+                    "email": "qa@screenshotone.com",
+                    "marketing_consent": 1
+                }
+            },
+            {
+                "id": 1790992,
+                "alert_name": "subscription_created",
+                "status": "failed",
+                "created_at": "2022-06-14 13:40:05",
+                "updated_at": "2022-06-14 14:40:05",
+                "attempts": 3,
+                "fields": {
+                    "cancel_url": "https://sandbox-subscription-management.paddle.com/subscription/264546/hash/ea6e498c94d13/cancel",
+                    "checkout_id": "7814479-chre0e6f7ff6b4d-34f2e6e1b0",
+                    "currency": "USD",
+                    "email": "qa@screenshotone.com",
+                    "event_time": "2022-06-14 13:40:05",
+                    "marketing_consent": true,
+                    "next_bill_date": "2022-07-14",
+                    "passthrough": null,
+                    "quantity": 1,
+                    "source": "",
+                    "status": "active",
+                    "subscription_id": 264546,
+                    "subscription_plan_id": 26100,
+                    "unit_price": "7.00",
+                    "user_id": 176032,
+                    "update_url": "https://sandbox-subscription-management.paddle.com/subscription/264546/hash/ea6e498c94d13/update"
+                }
+            }
+        ]
+    }
+}`
@@ -10,8 +10,10 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 	"reflect"
 	"sort"
+	"strconv"
 	"time"
 
 	"github.com/gorilla/schema"
@@ -47,14 +49,64 @@ const (
 	RefundPartial RefundType = "partial"
 )
 
+// DisputeStatus represents payment dispute status: open or closed.
+type DisputeStatus string
+
+const (
+	disputeUnknown DisputeStatus = "unknown"
+	// DisputeOpen represents open dispute, the disputed amount is on hold.
+	DisputeOpen DisputeStatus = "open"
+	// DisputeClosed represents closed dispute.
+	DisputeClosed DisputeStatus = "closed"
+)
+
+// RiskReviewStatus represents high risk transaction review outcome: pending, accepted or rejected.
+type RiskReviewStatus string
+
+const (
+	riskReviewUnknown RiskReviewStatus = "unknown"
+	// RiskReviewPending represents transaction that is still under review.
+	RiskReviewPending RiskReviewStatus = "pending"
+	// RiskReviewAccepted represents transaction that is accepted after the review.
+	RiskReviewAccepted RiskReviewStatus = "accepted"
+	// RiskReviewRejected represents transaction that is rejected after the review.
+	RiskReviewRejected RiskReviewStatus = "rejected"
+)
+
+// InvoiceStatus represents invoice status: unpaid, paid or overdue.
+type InvoiceStatus string
+
+const (
+	invoiceUnknown InvoiceStatus = "unknown"
+	// InvoiceUnpaid represents sent, but not yet paid invoice.
+	InvoiceUnpaid InvoiceStatus = "unpaid"
+	// InvoicePaid represents paid invoice.
+	InvoicePaid InvoiceStatus = "paid"
+	// InvoiceOverdue represents invoice that is not paid within the payment terms.
+	InvoiceOverdue InvoiceStatus = "overdue"
+)
+
 // Webhooks validates and parses webhook alerts.
 type Webhooks struct {
 	signKey *rsa.PublicKey
 	decoder *schema.Decoder
+	lenient bool
+}
+
+// WebhooksOption configures the webhooks.
+type WebhooksOption func(*Webhooks)
+
+// WithLenientAlerts makes the webhooks return *UnknownAlert for the verified alerts with unknown "alert_name",
+// instead of failing, so the new alert types introduced by Paddle don't break the webhook endpoint.
+// By default, the webhooks are strict and return an error.
+func WithLenientAlerts() WebhooksOption {
+	return func(webhooks *Webhooks) {
+		webhooks.lenient = true
+	}
 }
 
 // NewWebhooks returns a new instance of the webhooks.
-func NewWebhooks(publicKey []byte) (*Webhooks, error) {
+func NewWebhooks(publicKey []byte, options ...WebhooksOption) (*Webhooks, error) {
 	pemBlock, _ := pem.Decode(publicKey)
 	if pemBlock == nil {
 		return nil, errors.New("failed to locate public key PEM block")
@@ -76,8 +128,16 @@ func NewWebhooks(publicKey []byte) (*Webhooks, error) {
 	decoder.RegisterConverter(OptionalTime{}, convertOptionalTime)
 	decoder.RegisterConverter(subscriptionUnknown, convertSubscriptionStatus)
 	decoder.RegisterConverter(refundUnknown, convertRefundType)
+	decoder.RegisterConverter(disputeUnknown, convertDisputeStatus)
+	decoder.RegisterConverter(riskReviewUnknown, convertRiskReviewStatus)
+	decoder.RegisterConverter(invoiceUnknown, convertInvoiceStatus)
 
-	return &Webhooks{signKey: signKey, decoder: decoder}, nil
+	webhooks := &Webhooks{signKey: signKey, decoder: decoder}
+	for _, option := range options {
+		option(webhooks)
+	}
+
+	return webhooks, nil
 }
 
 func convertOptionalTime(value string) reflect.Value {
@@ -138,8 +198,46 @@ func convertRefundType(value string) reflect.Value {
 	return reflect.Value{}
 }
 
+func convertDisputeStatus(value string) reflect.Value {
+	switch value {
+	case "open":
+		return reflect.ValueOf(DisputeOpen)
+	case "closed":
+		return reflect.ValueOf(DisputeClosed)
+	}
+
+	return reflect.Value{}
+}
+
+func convertRiskReviewStatus(value string) reflect.Value {
+	switch value {
+	case "pending":
+		return reflect.ValueOf(RiskReviewPending)
+	case "accepted":
+		return reflect.ValueOf(RiskReviewAccepted)
+	case "rejected":
+		return reflect.ValueOf(RiskReviewRejected)
+	}
+
+	return reflect.Value{}
+}
+
+func convertInvoiceStatus(value string) reflect.Value {
+	switch value {
+	case "unpaid":
+		return reflect.ValueOf(InvoiceUnpaid)
+	case "paid":
+		return reflect.ValueOf(InvoicePaid)
+	case "overdue":
+		return reflect.ValueOf(InvoiceOverdue)
+	}
+
+	return reflect.Value{}
+}
+
 // ParseRequest validates the Paddle webhook request and returns typed alert in case of success,
-// otherwise it returns an error.
+// otherwise it returns an error. Alerts with unknown "alert_name" are returned as *UnknownAlert
+// if the webhooks are created with WithLenientAlerts.
 func (webhooks *Webhooks) ParseRequest(r *http.Request) (interface{}, error) {
 	if contentType := r.Header.Get("Content-Type"); contentType != "application/x-www-form-urlencoded" {
 		return nil, fmt.Errorf("webhook request has unsupported \"Content-Type\": %s", contentType)
@@ -175,8 +273,13 @@ func (webhooks *Webhooks) ParseRequest(r *http.Request) (interface{}, error) {
 		return nil, fmt.Errorf("failed to verify the signature: %w", err)
 	}
 
+	return webhooks.decode(r.Form)
+}
+
+// decode decodes the verified form values into a typed alert.
+func (webhooks *Webhooks) decode(values url.Values) (interface{}, error) {
 	var alert interface{}
-	alertName := r.Form.Get("alert_name")
+	alertName := values.Get("alert_name")
 
 	switch alertName {
 	case "subscription_created":
@@ -192,28 +295,64 @@ func (webhooks *Webhooks) ParseRequest(r *http.Request) (interface{}, error) {
 	case "subscription_payment_refunded":
 		alert = &SubscriptionPaymentRefundedAlert{}
 	case "payment_succeeded":
+		alert = &PaymentSucceededAlert{}
 	case "payment_refunded":
-	case "locker_processed":
+		alert = &PaymentRefundedAlert{}
 	case "payment_dispute_created":
+		alert = &PaymentDisputeCreatedAlert{}
 	case "payment_dispute_closed":
+		alert = &PaymentDisputeClosedAlert{}
 	case "high_risk_transaction_created":
+		alert = &HighRiskTransactionCreatedAlert{}
 	case "high_risk_transaction_updated":
+		alert = &HighRiskTransactionUpdatedAlert{}
+	case "locker_processed":
+		alert = &LockerProcessedAlert{}
 	case "transfer_created":
+		alert = &TransferCreatedAlert{}
 	case "transfer_paid":
+		alert = &TransferPaidAlert{}
 	case "new_audience_member":
+		alert = &NewAudienceMemberAlert{}
 	case "update_audience_member":
+		alert = &UpdateAudienceMemberAlert{}
 	case "invoice_paid":
+		alert = &InvoicePaidAlert{}
 	case "invoice_sent":
+		alert = &InvoiceSentAlert{}
 	case "invoice_overdue":
-		return nil, fmt.Errorf("not implemented \"alert_name\": %v", alertName)
+		alert = &InvoiceOverdueAlert{}
 	default:
-		return nil, fmt.Errorf("unknown \"alert_name\": %v", alertName)
+		if !webhooks.lenient {
+			return nil, fmt.Errorf("unknown \"alert_name\": %v", alertName)
+		}
+
+		return newUnknownAlert(values), nil
 	}
 
-	err = webhooks.decoder.Decode(alert, r.Form)
+	err := webhooks.decoder.Decode(alert, values)
 	if err != nil {
 		return nil, fmt.Errorf("failed to decode the form values: %w", err)
 	}
 
 	return alert, nil
 }
+
+// newUnknownAlert builds the unknown alert without the schema decoder, since the fields of new alerts
+// may not follow the formats of the known ones, alert_id and event_time are parsed on a best-effort basis.
+func newUnknownAlert(values url.Values) *UnknownAlert {
+	alert := &UnknownAlert{AlertName: values.Get("alert_name"), Values: values}
+	if alertID, err := strconv.ParseUint(values.Get("alert_id"), 10, 64); err == nil {
+		alert.AlertID = alertID
+	}
+
+	eventTime := values.Get("event_time")
+	for _, layout := range []string{"2006-01-02 15:04:05", "2006-01-02", time.RFC3339} {
+		if t, err := time.Parse(layout, eventTime); err == nil {
+			alert.EventTime = OptionalTime{Time: t, Set: true}
+			break
+		}
+	}
+
+	return alert
+}
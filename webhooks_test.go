@@ -6,6 +6,7 @@ import (
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestWebhooksErrorsOnInvalidHeader(t *testing.T) {
@@ -192,9 +193,381 @@ func TestSubscriptionSubscriptionCreatedIsParsed(t *testing.T) {
 	}
 }
 
+func TestPaymentSucceededIsParsed(t *testing.T) {
+	alert := parseSignedAlert(t, publicKeyEncodedForAlerts, paymentSucceededPostBody)
+
+	paymentSucceeded, isPaymentSucceeded := alert.(*PaymentSucceededAlert)
+	if !isPaymentSucceeded {
+		t.Fatalf("alert is not of type *PaymentSucceededAlert")
+		return
+	}
+
+	equals(t, uint64(1902385), paymentSucceeded.AlertID)
+	equals(t, "44.45", *paymentSucceeded.BalanceEarnings)
+	equals(t, "SPRING22", *paymentSucceeded.Coupon)
+	equals(t, "Sam Miller", *paymentSucceeded.CustomerName)
+	equals(t, time.Date(2022, 6, 14, 13, 38, 20, 0, time.UTC), paymentSucceeded.EventTime)
+	equals(t, true, paymentSucceeded.MarketingConsent)
+	equals(t, "384786-7814479", *paymentSucceeded.OrderID)
+	equals(t, "{\"account_id\":42}", *paymentSucceeded.Passthrough)
+	equals(t, uint64(514032), paymentSucceeded.ProductID)
+	equals(t, "https://sandbox-my.paddle.com/receipt/384786-7814479/1042907-chre0e6f7ff6b4d-34f2e6e1b0", *paymentSucceeded.ReceiptURL)
+	equals(t, true, paymentSucceeded.UsedPriceOverride)
+}
+
+func TestPaymentRefundedIsParsed(t *testing.T) {
+	alert := parseSignedAlert(t, publicKeyEncodedForAlerts, paymentRefundedPostBody)
+
+	paymentRefunded, isPaymentRefunded := alert.(*PaymentRefundedAlert)
+	if !isPaymentRefunded {
+		t.Fatalf("alert is not of type *PaymentRefundedAlert")
+		return
+	}
+
+	equals(t, uint64(1902511), paymentRefunded.AlertID)
+	equals(t, "24.50", *paymentRefunded.Amount)
+	equals(t, "22.22", *paymentRefunded.BalanceEarningsDecrease)
+	equals(t, "Customer changed their mind", *paymentRefunded.RefundReason)
+	equals(t, RefundPartial, paymentRefunded.RefundType)
+	equals(t, false, paymentRefunded.MarketingConsent)
+}
+
+func TestPaymentDisputeCreatedIsParsed(t *testing.T) {
+	alert := parseSignedAlert(t, publicKeyEncodedForAlerts, paymentDisputeCreatedPostBody)
+
+	disputeCreated, isDisputeCreated := alert.(*PaymentDisputeCreatedAlert)
+	if !isDisputeCreated {
+		t.Fatalf("alert is not of type *PaymentDisputeCreatedAlert")
+		return
+	}
+
+	equals(t, uint64(1903120), disputeCreated.AlertID)
+	equals(t, "49.00", *disputeCreated.Amount)
+	equals(t, "15.00", *disputeCreated.FeeUSD)
+	equals(t, "384786-7814479", *disputeCreated.OrderID)
+	equals(t, DisputeOpen, disputeCreated.Status)
+}
+
+func TestPaymentDisputeClosedIsParsed(t *testing.T) {
+	alert := parseSignedAlert(t, publicKeyEncodedForAlerts, paymentDisputeClosedPostBody)
+
+	disputeClosed, isDisputeClosed := alert.(*PaymentDisputeClosedAlert)
+	if !isDisputeClosed {
+		t.Fatalf("alert is not of type *PaymentDisputeClosedAlert")
+		return
+	}
+
+	equals(t, uint64(1903544), disputeClosed.AlertID)
+	equals(t, time.Date(2022, 7, 20, 16, 40, 11, 0, time.UTC), disputeClosed.EventTime)
+	equals(t, DisputeClosed, disputeClosed.Status)
+}
+
+func TestHighRiskTransactionCreatedIsParsed(t *testing.T) {
+	alert := parseSignedAlert(t, publicKeyEncodedForAlerts, highRiskTransactionCreatedPostBody)
+
+	transactionCreated, isTransactionCreated := alert.(*HighRiskTransactionCreatedAlert)
+	if !isTransactionCreated {
+		t.Fatalf("alert is not of type *HighRiskTransactionCreatedAlert")
+		return
+	}
+
+	equals(t, uint64(8821), transactionCreated.CaseID)
+	equals(t, time.Date(2022, 7, 21, 10, 2, 45, 0, time.UTC), transactionCreated.CreatedAt)
+	equals(t, "risky@example.com", *transactionCreated.CustomerEmailAddress)
+	equals(t, uint64(176099), transactionCreated.CustomerUserID)
+	equals(t, "78.5", *transactionCreated.RiskScore)
+	equals(t, RiskReviewPending, transactionCreated.Status)
+}
+
+func TestHighRiskTransactionUpdatedIsParsed(t *testing.T) {
+	alert := parseSignedAlert(t, publicKeyEncodedForAlerts, highRiskTransactionUpdatedPostBody)
+
+	transactionUpdated, isTransactionUpdated := alert.(*HighRiskTransactionUpdatedAlert)
+	if !isTransactionUpdated {
+		t.Fatalf("alert is not of type *HighRiskTransactionUpdatedAlert")
+		return
+	}
+
+	equals(t, uint64(8821), transactionUpdated.CaseID)
+	equals(t, "384901-7815002", *transactionUpdated.OrderID)
+	equals(t, RiskReviewRejected, transactionUpdated.Status)
+}
+
+func TestHighRiskTransactionWithUnknownStatusIsRejected(t *testing.T) {
+	publicKey, err := base64.StdEncoding.DecodeString(publicKeyEncodedForAlerts)
+	ok(t, err)
+
+	webhooks, err := NewWebhooks(publicKey)
+	ok(t, err)
+
+	_, err = webhooks.decode(url.Values{"alert_name": {"high_risk_transaction_updated"}, "status": {"escalated"}})
+	errorred(t, err, "failed to decode the form values")
+}
+
+func TestLockerProcessedIsParsed(t *testing.T) {
+	alert := parseSignedAlert(t, publicKeyEncodedForAlerts, lockerProcessedPostBody)
+
+	lockerProcessed, isLockerProcessed := alert.(*LockerProcessedAlert)
+	if !isLockerProcessed {
+		t.Fatalf("alert is not of type *LockerProcessedAlert")
+		return
+	}
+
+	equals(t, uint64(1904010), lockerProcessed.AlertID)
+	equals(t, false, lockerProcessed.CheckoutRecovery)
+	equals(t, "https://example.com/download", *lockerProcessed.Download)
+	equals(t, "Enter the license code in the app.", *lockerProcessed.Instructions)
+	equals(t, "D6A1B3E6-1B5C3A1F", *lockerProcessed.Licence)
+	equals(t, "384786-7814479", *lockerProcessed.OrderID)
+	equals(t, uint64(514032), lockerProcessed.ProductID)
+}
+
+func TestTransferCreatedIsParsed(t *testing.T) {
+	alert := parseSignedAlert(t, publicKeyEncodedForAlerts, transferCreatedPostBody)
+
+	transferCreated, isTransferCreated := alert.(*TransferCreatedAlert)
+	if !isTransferCreated {
+		t.Fatalf("alert is not of type *TransferCreatedAlert")
+		return
+	}
+
+	equals(t, "1520.35", *transferCreated.Amount)
+	equals(t, uint64(3317), transferCreated.PayoutID)
+	equals(t, "unpaid", *transferCreated.Status)
+}
+
+func TestTransferPaidIsParsed(t *testing.T) {
+	alert := parseSignedAlert(t, publicKeyEncodedForAlerts, transferPaidPostBody)
+
+	transferPaid, isTransferPaid := alert.(*TransferPaidAlert)
+	if !isTransferPaid {
+		t.Fatalf("alert is not of type *TransferPaidAlert")
+		return
+	}
+
+	equals(t, uint64(3317), transferPaid.PayoutID)
+	equals(t, time.Date(2022, 7, 5, 11, 20, 40, 0, time.UTC), transferPaid.EventTime)
+	equals(t, "paid", *transferPaid.Status)
+}
+
+func TestNewAudienceMemberIsParsed(t *testing.T) {
+	alert := parseSignedAlert(t, publicKeyEncodedForAlerts, newAudienceMemberPostBody)
+
+	newAudienceMember, isNewAudienceMember := alert.(*NewAudienceMemberAlert)
+	if !isNewAudienceMember {
+		t.Fatalf("alert is not of type *NewAudienceMemberAlert")
+		return
+	}
+
+	equals(t, "qa@screenshotone.com", *newAudienceMember.Email)
+	equals(t, "514032,514033", *newAudienceMember.Products)
+	equals(t, true, newAudienceMember.Subscribed)
+	equals(t, uint64(176032), newAudienceMember.UserID)
+}
+
+func TestUpdateAudienceMemberIsParsed(t *testing.T) {
+	alert := parseSignedAlert(t, publicKeyEncodedForAlerts, updateAudienceMemberPostBody)
+
+	updateAudienceMember, isUpdateAudienceMember := alert.(*UpdateAudienceMemberAlert)
+	if !isUpdateAudienceMember {
+		t.Fatalf("alert is not of type *UpdateAudienceMemberAlert")
+		return
+	}
+
+	equals(t, "sam@screenshotone.com", *updateAudienceMember.NewCustomerEmail)
+	equals(t, false, updateAudienceMember.NewMarketingConsent)
+	equals(t, "qa@screenshotone.com", *updateAudienceMember.OldCustomerEmail)
+	equals(t, true, updateAudienceMember.OldMarketingConsent)
+	equals(t, time.Date(2022, 8, 2, 10, 11, 10, 0, time.UTC), updateAudienceMember.UpdatedAt)
+}
+
+func TestInvoicePaidIsParsed(t *testing.T) {
+	alert := parseSignedAlert(t, publicKeyEncodedForAlerts, invoicePaidPostBody)
+
+	invoicePaid, isInvoicePaid := alert.(*InvoicePaidAlert)
+	if !isInvoicePaid {
+		t.Fatalf("alert is not of type *InvoicePaidAlert")
+		return
+	}
+
+	equals(t, uint64(61003), invoicePaid.PaymentID)
+	equals(t, InvoicePaid, invoicePaid.Status)
+	equals(t, "1140.00", *invoicePaid.BalanceEarnings)
+	equals(t, OptionalTime{Time: time.Date(2022, 9, 20, 12, 30, 0, 0, time.UTC), Set: true}, invoicePaid.DateReconciled)
+	equals(t, "PO-2022-0042", *invoicePaid.PurchaseOrderNumber)
+	equals(t, 30, invoicePaid.TermDays)
+	equals(t, uint64(514040), invoicePaid.InvoiceProduct.ProductID)
+	equals(t, "Annual enterprise plan", *invoicePaid.InvoiceProduct.ProductAdditionalInformation)
+	equals(t, uint64(9021), invoicePaid.InvoiceCustomer.CustomerID)
+	equals(t, "DE123456789", *invoicePaid.InvoiceCustomer.CustomerVATNumber)
+	equals(t, "Friedrichstraße 123", *invoicePaid.InvoiceCustomer.InvoiceAddress.Address)
+	equals(t, "10117", *invoicePaid.InvoiceCustomer.InvoiceAddress.Zipcode)
+	equals(t, "DE", *invoicePaid.InvoiceCustomer.InvoiceAddress.Country)
+	equals(t, uint64(742), invoicePaid.InvoiceContract.ContractID)
+	equals(t, OptionalTime{Time: time.Date(2023, 8, 31, 0, 0, 0, 0, time.UTC), Set: true}, invoicePaid.InvoiceContract.ContractEndDate)
+}
+
+func TestInvoiceSentIsParsed(t *testing.T) {
+	alert := parseSignedAlert(t, publicKeyEncodedForAlerts, invoiceSentPostBody)
+
+	invoiceSent, isInvoiceSent := alert.(*InvoiceSentAlert)
+	if !isInvoiceSent {
+		t.Fatalf("alert is not of type *InvoiceSentAlert")
+		return
+	}
+
+	equals(t, InvoiceUnpaid, invoiceSent.Status)
+	equals(t, time.Date(2022, 9, 1, 9, 5, 0, 0, time.UTC), invoiceSent.InvoicedAt)
+	equals(t, "Acme GmbH", *invoiceSent.CustomerName)
+	equals(t, "billing@acme.example", *invoiceSent.Email)
+}
+
+func TestInvoiceOverdueIsParsed(t *testing.T) {
+	alert := parseSignedAlert(t, publicKeyEncodedForAlerts, invoiceOverduePostBody)
+
+	invoiceOverdue, isInvoiceOverdue := alert.(*InvoiceOverdueAlert)
+	if !isInvoiceOverdue {
+		t.Fatalf("alert is not of type *InvoiceOverdueAlert")
+		return
+	}
+
+	equals(t, uint64(61877), invoiceOverdue.PaymentID)
+	equals(t, InvoiceOverdue, invoiceOverdue.Status)
+	equals(t, uint64(0), invoiceOverdue.ContractID)
+	equals(t, OptionalTime{}, invoiceOverdue.ContractStartDate)
+	equals(t, "Berlin", *invoiceOverdue.City)
+}
+
+func TestUnknownAlertIsRejectedByDefault(t *testing.T) {
+	publicKey, err := base64.StdEncoding.DecodeString(publicKeyEncodedForAlerts)
+	ok(t, err)
+
+	webhooks, err := NewWebhooks(publicKey)
+	ok(t, err)
+
+	r, err := http.NewRequest("POST", "https://example.com/hooks", strings.NewReader(unknownAlertPostBody))
+	ok(t, err)
+	r.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+
+	_, err = webhooks.ParseRequest(r)
+	errorred(t, err, "unknown \"alert_name\": subscription_scheduled_change")
+}
+
+func TestUnknownAlertIsParsedWithLenientAlerts(t *testing.T) {
+	alert := parseSignedAlert(t, publicKeyEncodedForAlerts, unknownAlertPostBody, WithLenientAlerts())
+
+	unknownAlert, isUnknownAlert := alert.(*UnknownAlert)
+	if !isUnknownAlert {
+		t.Fatalf("alert is not of type *UnknownAlert")
+		return
+	}
+
+	equals(t, "subscription_scheduled_change", unknownAlert.AlertName)
+	equals(t, uint64(1906001), unknownAlert.AlertID)
+	equals(t, OptionalTime{Time: time.Date(2022, 12, 1, 10, 0, 0, 0, time.UTC), Set: true}, unknownAlert.EventTime)
+	equals(t, "250148", unknownAlert.Values.Get("subscription_id"))
+	equals(t, true, unknownAlert.Values.Has("p_signature"))
+}
+
+func TestUnknownAlertWithUnfamiliarFieldsIsParsedWithLenientAlerts(t *testing.T) {
+	publicKey, err := base64.StdEncoding.DecodeString(publicKeyEncodedForAlerts)
+	ok(t, err)
+
+	webhooks, err := NewWebhooks(publicKey, WithLenientAlerts())
+	ok(t, err)
+
+	alert, err := webhooks.decode(url.Values{"alert_name": {"future_alert"}, "alert_id": {"evt_01gm"}, "event_time": {"2022-12-01T10:00:00Z"}})
+	ok(t, err)
+	equals(t, &UnknownAlert{
+		AlertName: "future_alert",
+		EventTime: OptionalTime{Time: time.Date(2022, 12, 1, 10, 0, 0, 0, time.UTC), Set: true},
+		Values:    url.Values{"alert_name": {"future_alert"}, "alert_id": {"evt_01gm"}, "event_time": {"2022-12-01T10:00:00Z"}},
+	}, alert)
+
+	alert, err = webhooks.decode(url.Values{"alert_name": {"future_alert"}, "alert_id": {"42"}, "event_time": {"01/12/2022 10:00"}})
+	ok(t, err)
+	equals(t, uint64(42), alert.(*UnknownAlert).AlertID)
+	equals(t, OptionalTime{}, alert.(*UnknownAlert).EventTime)
+
+	alert, err = webhooks.decode(url.Values{"alert_name": {"future_alert"}, "event_time": {""}})
+	ok(t, err)
+	equals(t, OptionalTime{}, alert.(*UnknownAlert).EventTime)
+
+	alert, err = webhooks.decode(url.Values{"alert_name": {"future_alert"}})
+	ok(t, err)
+	equals(t, OptionalTime{}, alert.(*UnknownAlert).EventTime)
+}
+
+func TestUnknownAlertWithLenientAlertsRequiresSignature(t *testing.T) {
+	publicKey, err := base64.StdEncoding.DecodeString(publicKeyEncodedForAlerts)
+	ok(t, err)
+
+	webhooks, err := NewWebhooks(publicKey, WithLenientAlerts())
+	ok(t, err)
+
+	query, err := url.ParseQuery(unknownAlertPostBody)
+	ok(t, err)
+	query.Set("user_id", "42")
+
+	r, err := http.NewRequest("POST", "https://example.com/hooks", strings.NewReader(query.Encode()))
+	ok(t, err)
+	r.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+
+	_, err = webhooks.ParseRequest(r)
+	errorred(t, err, "failed to verify the signature")
+}
+
+// parseSignedAlert parses the signed webhook request body and fails the test on any error.
+func parseSignedAlert(t *testing.T, publicKeyEncoded string, postBody string, options ...WebhooksOption) interface{} {
+	t.Helper()
+
+	publicKey, err := base64.StdEncoding.DecodeString(publicKeyEncoded)
+	if err != nil {
+		t.Fatalf("failed to parse public key: %s", err)
+		return nil
+	}
+
+	webhooks, err := NewWebhooks(publicKey, options...)
+	if err != nil {
+		t.Fatalf("failed to instantiate webhooks: %s", err)
+		return nil
+	}
+
+	r, err := http.NewRequest("POST", "https://example.com/hooks", strings.NewReader(postBody))
+	if err != nil {
+		t.Fatalf("failed to instantiate new request: %s", err)
+		return nil
+	}
+	r.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+
+	alert, err := webhooks.ParseRequest(r)
+	if err != nil {
+		t.Fatalf("failed to parse request: %s", err)
+		return nil
+	}
+
+	return alert
+}
+
 const publicKeyEncodedForPaymentSucceeded = "LS0tLS1CRUdJTiBQVUJMSUMgS0VZLS0tLS0KTUlJQ0lqQU5CZ2txaGtpRzl3MEJBUUVGQUFPQ0FnOEFNSUlDQ2dLQ0FnRUEySEJEWjgycHZqY1dzVzRYQ2RLRApUeGYxcUp3ZjJ0MFhUOHcyUlVLVnd4QXVzWEJrM0huZWFIZkRPT1ZNWEUyODRDYmNZOWQvajREVlVQU0p3c2ZkCjZ1dyt6OERYb3lFWWRBVEU1eXBTVlVtNXByV0ZNMzJ4K3dVVWh1REw1MnBQbGpjKzcrYTdXL3o1OUc3V1pPK3MKaTlnQTFVbXBDRWhySWlWbk85OThBem9NUS9WemQ0Sm05ajhlN0dWSnUwR1lMMXF3eDVGeHV5SGEySnZ5L1RlYwpMejBYbVNzbzZLM3pRclYzVkNvYzJUd1N0RFFDMldLK01EQ3B3SmcwQi9FcCtIMktub043NFpDcEpkaGVFWGxoCkRJTkFyZy8yRERNNUUrQnNyS2czZEZyU2pjbnFsVTA4akRnSnVmMzdEQld4ZFNMa09nL2pTVlNCdHhqMlBtTE4KWThWME9rMy85czVybVgwdW9LaG9md2VXdER2T2JNMWE5d21saHlRWlNoc2tvWWJKbDQzM081YTMxY1ozYStKWgpnSU5TajdMSHMyMnNvQjRXQ0cvY25JQVcxbUhraU5tUnY1ZUxKeXIyZS8vSDdnSEhGRmh6ZkM5MnVabVQ4RWRuCkdhUjRWTDBMMjhnQW9pTktqUXc0RGdQZFJxRk1QNXkzR1loVm1rdk14a2VXaWQwekVvcFFFZ240akpiMkNLMUUKWkdtb3RYQUpGVXFndGM4NDJhdGZvK2pscjE5MGljUEJpcEM0Ykg4bUhpcU1yTzRwMGRocVZKS3kyQzJsMkkxOAo1cE0va0t0SCtiWitYUnR3RTlTWk5UUjJvU29hcEFlSEhSMy9kMlZub2JoOC9sbTBpRVJUM3N6K1k2NTh4THE5CjdoU0Z3Vk1uQ3pZb0wrV2ZxZFpNQUFFQ0F3RUFBUT09Ci0tLS0tRU5EIFBVQkxJQyBLRVktLS0tLQ=="
 const publicKeyEncodedForSubscriptionCreated = "LS0tLS1CRUdJTiBQVUJMSUMgS0VZLS0tLS0KTUlJQ0lqQU5CZ2txaGtpRzl3MEJBUUVGQUFPQ0FnOEFNSUlDQ2dLQ0FnRUEzcyt6SzR0MjJGWm81SjdWb2QvbQpLKzlPV1BrdkpyeGZvazA0aktNdVk4N3BHU1hyeWxzVWdPZUFQV2NvMDduODROT0o0c0xLTm9FaGJHaVhVZVlxCnB5NUp6UmJsUU9JUnZtQS8yZFlrcFd2WUYxL051aTNPSWZ3Ui9tWGhad3FxcUo0Nk9FU3pxUTBJZ2xCRC92dVMKTzNZbzUxS1BGb0dCTXFGYkRoODVFc0VLaWtiQmpPWjk4M1ZWTkVSUEpuV3p4dDBteVZFZ2l1ZWRZVEFiM3RyQQp6RDFKaTdaeDVDRjA5SGhRK0J6eVg4SW9UdytrQW5Sc3RqYVpEK0hLYVc3aVAzdnNPeW9uOHk4b1dZVlYwTnZxCmJBMXIwNHFpTnBuQ0dSTzdXQ1BWOGhPWXUrRXVUbUlqZ0JFNWNqbk1QRWVSMlpFSGZhTXBIUWZudk1kZlVIZU0KYU5jWkpVUEJQRFRqRDNwVGpZMXpZbHllZjFiU2llNTNSK3NUTnE1ZjVmbjFURmorUko3TmloamNYQ0habnlyawpUTDM2aUdNTkkvWnNTbk80c0NJOW5nTStZeHVDTktlbUgrbk9CTWRqYWlXL0RkVm96U0hXWXhjeGhxMW0vck03Cm9NcW9ZbitlMWhNS0I0SU02bjltN1RqTnhKVm10MGtFV3BVSVlDbE9tQTJ6bWw1ZFdQVjZNYTlqRjZDcHFSR3YKcDVObEZZMWJjUkU5L3FxeVNnNWdSMEJFK2R1TWthaWdyMUJsOWVWNXpFZDNPYmZaNm9xanpkMnZyTTM1TWJjegp3bU5sdmptMjRRUSt5ZHRSMXdvQVgyLzRsOFBqK05IV0JpOGN0WHZhTDAxaDF4c28vQ0R0NG8rNCtOL3liNDU0CmdiZ2M0NktyUmF1YnpnZlRkMkphVFBzQ0F3RUFBUT09Ci0tLS0tRU5EIFBVQkxJQyBLRVktLS0tLQo="
 const publicKeyEncodedForSubscriptionPaymentRefunded = "LS0tLS1CRUdJTiBQVUJMSUMgS0VZLS0tLS0KTUlJQ0lqQU5CZ2txaGtpRzl3MEJBUUVGQUFPQ0FnOEFNSUlDQ2dLQ0FnRUEzcyt6SzR0MjJGWm81SjdWb2QvbQpLKzlPV1BrdkpyeGZvazA0aktNdVk4N3BHU1hyeWxzVWdPZUFQV2NvMDduODROT0o0c0xLTm9FaGJHaVhVZVlxCnB5NUp6UmJsUU9JUnZtQS8yZFlrcFd2WUYxL051aTNPSWZ3Ui9tWGhad3FxcUo0Nk9FU3pxUTBJZ2xCRC92dVMKTzNZbzUxS1BGb0dCTXFGYkRoODVFc0VLaWtiQmpPWjk4M1ZWTkVSUEpuV3p4dDBteVZFZ2l1ZWRZVEFiM3RyQQp6RDFKaTdaeDVDRjA5SGhRK0J6eVg4SW9UdytrQW5Sc3RqYVpEK0hLYVc3aVAzdnNPeW9uOHk4b1dZVlYwTnZxCmJBMXIwNHFpTnBuQ0dSTzdXQ1BWOGhPWXUrRXVUbUlqZ0JFNWNqbk1QRWVSMlpFSGZhTXBIUWZudk1kZlVIZU0KYU5jWkpVUEJQRFRqRDNwVGpZMXpZbHllZjFiU2llNTNSK3NUTnE1ZjVmbjFURmorUko3TmloamNYQ0habnlyawpUTDM2aUdNTkkvWnNTbk80c0NJOW5nTStZeHVDTktlbUgrbk9CTWRqYWlXL0RkVm96U0hXWXhjeGhxMW0vck03Cm9NcW9ZbitlMWhNS0I0SU02bjltN1RqTnhKVm10MGtFV3BVSVlDbE9tQTJ6bWw1ZFdQVjZNYTlqRjZDcHFSR3YKcDVObEZZMWJjUkU5L3FxeVNnNWdSMEJFK2R1TWthaWdyMUJsOWVWNXpFZDNPYmZaNm9xanpkMnZyTTM1TWJjegp3bU5sdmptMjRRUSt5ZHRSMXdvQVgyLzRsOFBqK05IV0JpOGN0WHZhTDAxaDF4c28vQ0R0NG8rNCtOL3liNDU0CmdiZ2M0NktyUmF1YnpnZlRkMkphVFBzQ0F3RUFBUT09Ci0tLS0tRU5EIFBVQkxJQyBLRVktLS0tLQ=="
 const subscriptionPaymentSucceededPostBody = "alert_id=1651572&alert_name=subscription_payment_succeeded&balance_currency=USD&balance_earnings=23.25&balance_fee=1.75&balance_gross=25&balance_tax=0&checkout_id=675737-chre19a993fdd63-1c4273c652&country=IL&coupon=&currency=USD&customer_name=chromium&earnings=23.25&email=qa%40screenshotone.com&event_time=2022-05-03+13%3A36%3A29&fee=1.75&initial_payment=1&instalments=1&marketing_consent=0&next_bill_date=2022-06-03&next_payment_amount=25&order_id=317366-1864142&passthrough=REhmFjVR3YcJI8D15lDi9whlBEwdcGWoyFMNvo4cSbjqMWWC%2F5TLOVA%3D&payment_method=card&payment_tax=0&plan_name=Essentials&quantity=1&receipt_url=http%3A%2F%2Fsandbox-my.paddle.com%2Freceipt%2F317366-1864142%2F675737-chre19a993fdd63-1c4273c652&sale_gross=25&status=active&subscription_id=250148&subscription_payment_id=1864142&subscription_plan_id=26279&unit_price=25.00&user_id=176032&p_signature=mPMiot4fcxKYeomJL8wpDiC6hYbssfs2nQaYfPfowd%2F3lui48feTzqBuXrY1Pp1Kwj6BG1TOkFNth%2FwErDQ0TnbiAVmOy0eWhaQvYTVGOoS0qA8cadvq4uJCHaDNbmvnidGiVEvxlaimXYcyZzHrsi123lmfLEddwvvTX%2BbsvXixI5l6vDneFuWqhNTyE1tlRCJHm9C2zDubNlvABVTCGe5%2FYQ4CS5dR5Iq8jYTZkEB6%2F0idppPUxRWnH6d1Aj3lP%2BQoGf%2FpzMYkzAFgGbK3Wa5go2bponf6T1dPKWq4hNKiKFeAQjexu9VHKcZguUFA1WgsEY4Gh5ahtASaSGoOIz2JhupzfCMMJF2i7WePf0MFun%2BCpmDBSo%2B1hDcOecj0z06P6nziE%2F3ylrw0TxjPslgT9Shuc%2FFeFeSd42fp5fWGrhUjXAyomJG5ZDBMVvwhTqxOa1ZK0y5rH2q%2BAn9wZnH3NKFblGRoVTcnfMk4WAZ%2BmPaN19ZQOz%2F24f29mMxk1p2JezahtNPptraYMt%2BXbHnp6OjX4eka3Auysr1WSL90T%2FSYLPcbzDYlhxNNAukHUW62dZZVMt0oulb1VAcl8O96EmXJoRRnOMUr%2BGRjJa1wl8onjuTMcos0b%2Bms8sZSOd7mSa2nB6KXmEO%2BS4Iy093CosjSngU1qJvD8neXHhE%3D"
 const subscriptionCreatedPostBody = "alert_id=1790992&alert_name=subscription_created&cancel_url=https%3A%2F%2Fsandbox-subscription-management.paddle.com%2Fsubscription%2F264546%2Fhash%2Fea6e498c94d13147ff9c89f44918b21c8cb23f23ecdc37c9f385a875795ce6be%2Fcancel&checkout_id=731012-chrecf4c85d4606-416a3fdb5a&currency=USD&email=qa%40screenshotone.com&event_time=2022-05-25+15%3A01%3A13&linked_subscriptions=&marketing_consent=0&next_bill_date=2022-06-25&passthrough=VxD6wDdRlQPANFV5i%2FTgqacBGK%2B0CHXg0ybpOLtrbyVwYzSfVwH1aIQ%3D&quantity=1&source=localhost%3A3000+%2F+localhost%3A3000&status=active&subscription_id=264546&subscription_plan_id=29418&unit_price=7.00&update_url=https%3A%2F%2Fsandbox-subscription-management.paddle.com%2Fsubscription%2F264546%2Fhash%2Fea6e498c94d13147ff9c89f44918b21c8cb23f23ecdc37c9f385a875795ce6be%2Fupdate&user_id=176032&p_signature=qlKsmHd8zb4zVC%2Bdjs%2B2HOo%2BZLDR1ViMLBCpl2wJjolEvSLCvGf8ncT6qT1w5MA1T96GsIa8fvXcgYmO17WZamw%2BcFCD3zCA%2BKm%2BABVIXoN8pdEQj30Zc36uhSpScyYIgdHE1dHYcsE%2BF4K51hjUccAAhbrcelvYG%2FG%2FtmSqfyAknNlt2pAt23J%2BMWlk6yDMZGny40oxHfBglE7OuViupFY4tJI2sNGtu73iK0U3JdXqsX1DHkFgD7hh16ouhyjvi%2BW5cCFqg7P5jrsRn2wu%2Bdy5Qi14%2Bh1bsNdz2GE4sVwVcn6CryKLAk8PwkfAOG5xMT99dwHkq6ppgmDmZGOSmGSdCr4YcDJdjPWji26hnxqyYSXz13UK53U9h3saVeSWe374kL0yzrfNjFDUSpWW4ClQLjIZ%2F3WIE47jW3SEIRVhM4fZPiB4s2ZBovsY%2BiTBFSpQCVNYn9veWwJ2dZS3WeAO1YCpv01ioT7xSRoHgRyoO1bQPk3r61qzBJigNHArT7Vq1quBmGhmh7O08PJTuCh0Q2lKOBjXuD1B3xQCqM3x%2Ft%2FHvdBo%2BmtEYHClvRnItMCliJ32GU%2F7%2FAg9G5Rv7vWPCKt8nYr4et1%2FY7Z%2F3gw1ZvvSx7diZg0pFP0TkQkiz9TuVgrOz6FqeWNWrZxIKxZub4Bj%2BJaLg8RF3T%2Bu5bw%3D"
 const subscriptionPaymentRefundedPostBody = "alert_id=1877471131&alert_name=subscription_payment_refunded&amount=15.17&balance_currency=EUR&balance_earnings_decrease=0.99&balance_fee_refund=0.48&balance_gross_refund=0.51&balance_tax_refund=0.15&checkout_id=5-575a57596786393-49632268db&currency=EUR&custom_data=custom_data&earnings_decrease=0.61&email=ppollich%40example.com&event_time=2022-09-27%2010%3A32%3A58&fee_refund=0.28&gross_refund=0.39&initial_payment=false&instalments=8&marketing_consent=&order_id=2&passthrough=Example%20String&quantity=10&refund_reason=refund_reason&refund_type=full&status=trialing&subscription_id=1&subscription_payment_id=4&subscription_plan_id=4&tax_refund=0&unit_price=unit_price&user_id=3&p_signature=h%2FQPN4aNU2JRsBi1Vv5UVSgzFJLagFNkJcAvh5y70S6Rp%2BVBh%2B%2FuSGmeZhdKwGzYeiRIf5L5Fmv8ZmpaneZ3DBjtU3KbJTLp6FNsYr0Y7TPkbZ%2B0oZ0ONI3rAf3YPXmvB5Oxg0fF0t9QWaKRkXLHTEmTcDnuYwfU5iwJV6AaRDqTxlZzOShj84RS0ZB4NO14hKDPgjd7fOqm8ODv4%2BuUSR1JZcgQFteF0KIXC7hZMJ6YPpZh2hzi3pVkrZINWy0gFxEQv8Ex7%2BR4Jd86hsjV79YJIw2FajUVxgPcC05RhpBFdS4SVJKurVrudh0Ji9x53JW9nO4EnRcgJQnAqk%2Bca3NdZIjbU%2F9x0cFn165uEQUCBTahfheoBUuCTm4MDzGOmMqxqzaUhrCkL%2FVX5NgopKwnRrXrSIl7SP2ooOqZpRTOji%2B9qJfhcH9LOgAQ1FGP0FKeMEJurwDZ%2FNFv4%2F129PkKoMVm6CjNrgMwnlngIfsfq9DcfYRmk3lP2MzKdNUj4NVyIR6uddy7FTiNrr7fo%2Fpha5E%2FS34XhIZtfls0toUOk2ErODIPyl1mZrFAJlH7ghebxi5DKdNhHZowK4iRM%2BW%2FhC8YrKRcG7ZLtwZxWstGoVPq%2FW8SxSlz1Gl6NGlxDNip3z4jjHY9ZTggx4kljFgcIb9L2F%2BbDU1chMws2ZE%3D"
+const publicKeyEncodedForAlerts = "LS0tLS1CRUdJTiBQVUJMSUMgS0VZLS0tLS0KTUlJQklqQU5CZ2txaGtpRzl3MEJBUUVGQUFPQ0FROEFNSUlCQ2dLQ0FRRUFuOXhzbmFzVng1cEwxRzlhN3RwYwpiR21VbXlGZ3RCZGVxSktHSUFVbHFCZlhrWC9KMCthdnlGUDR3Z0NiOGZKci9LSDBkWGJjOVpaS1k1cGFQdFZXCllESEk3SEVDVXIrdEd5ejVCdyt6UXc0bi9ZdjdrdlM2NVo3SnBBbk5OSjQ5all5WGVKaVE2dmJvTTY5WlJwT3AKeGZuWW1CNUhYUTZWRnl5emQ3YzFzV01yU01mdGxxVytHamI2d3NHc205V2gyT3Y4cWNjT1ZvMjY2Wm5ITnlGRgpGZUVXb09ScFE5eE9PTmRLVll2SmMxU0VmSTBBYjNBd3F3M2QrVUkzRy91b0hFd2NGOExxWk9SeTJHYWtxWHRDCk9PU1RkU3hoZG9qUWswVTZ5ZU9sL3RScnFpaVN1TjZFWHNBTzhUdkZ3U2VLZG1Idm1HMlpxdWJ6ZWNDeFNUME4Kb1FJREFRQUIKLS0tLS1FTkQgUFVCTElDIEtFWS0tLS0tCg=="
+const paymentSucceededPostBody = "alert_id=1902385&alert_name=payment_succeeded&balance_currency=USD&balance_earnings=44.45&balance_fee=3.05&balance_gross=49&balance_tax=1.50&checkout_id=1042907-chre0e6f7ff6b4d-34f2e6e1b0&country=DE&coupon=SPRING22&currency=USD&customer_name=Sam+Miller&earnings=44.45&email=qa%40screenshotone.com&event_time=2022-06-14+13%3A38%3A20&fee=3.05&ip=203.0.113.10&marketing_consent=1&order_id=384786-7814479&p_signature=GyBjtyDo%2BX5YUjEEfLJfgL2uI%2BrtBSf5rmDPwOa7EbXrDurd%2FvQsHYLjTKvtg2GyjUIQq%2F8G8R71cFETYhB3i8cEQydrrvdQ6x1ey%2BtaYwW8nj01YVYjtD2NRKkNTlTMMvhNjjaaaRDoezFm13t8AEzX9x4SuzpVof9qomvFOkcGYfqn9jwxSfMYUI%2BPGfZ3ZZInGeZqBgOYTYHoC3uMeCUOnlbJXr946ezbScFQd0sHTnqXiaCLNbbsELkyJh9U9UvQg%2F68x5oY90WFwVt22EnRMgtQY8W1y56RFVsKbICsofvqytqgYYnErIIWt5GwBNOk07a1A6WWtKCz47BMjg%3D%3D&passthrough=%7B%22account_id%22%3A42%7D&payment_method=card&payment_tax=1.50&product_id=514032&product_name=Desktop+app&quantity=1&receipt_url=https%3A%2F%2Fsandbox-my.paddle.com%2Freceipt%2F384786-7814479%2F1042907-chre0e6f7ff6b4d-34f2e6e1b0&sale_gross=49.00&used_price_override=1"
+const paymentRefundedPostBody = "alert_id=1902511&alert_name=payment_refunded&amount=24.50&balance_currency=USD&balance_earnings_decrease=22.22&balance_fee_refund=1.53&balance_gross_refund=24.50&balance_tax_refund=0.75&checkout_id=1042907-chre0e6f7ff6b4d-34f2e6e1b0&currency=USD&earnings_decrease=22.22&email=qa%40screenshotone.com&event_time=2022-06-20+09%3A12%3A44&fee_refund=1.53&gross_refund=24.50&marketing_consent=0&order_id=384786-7814479&p_signature=RTODQQ6soyajYIZX%2F5jMYYV9utamiCHqB3PRJnusnc8mh663yrWUz0CkHa2Ed2lfTpF59X3mJnYMDKhX9nlGcvrUE3Q9wJeUDxXINcvZdQzRA05KS7G9M6j6I7E3eJOPyBtrGIPW2djYy%2Bk9LiS3QmmJKq7R1WummOm2QfbuwlkIU3uOFMCscyQtIoaK4kTZQb66Xlzmp2sROGsGrVreun5jtBFsEF0M2%2BIEe%2F%2BRhKeBhVUHLUFNIVa%2BNAEeJ%2BCyFf%2B%2F4EJ%2Ff%2FBfoyeQT5rlZy7gaeC0erZ26JxeqCUj2tnoVXsUkhEA%2F9RVKLDoGdRD9a1sLIWT8Tk3V2U4xSEdJg%3D%3D&passthrough=%7B%22account_id%22%3A42%7D&quantity=1&refund_reason=Customer+changed+their+mind&refund_type=partial&tax_refund=0.75"
+const paymentDisputeCreatedPostBody = "alert_id=1903120&alert_name=payment_dispute_created&amount=49.00&balance_amount=49.00&balance_currency=USD&balance_fee=15.00&checkout_id=1042907-chre0e6f7ff6b4d-34f2e6e1b0&currency=USD&email=qa%40screenshotone.com&event_time=2022-07-02+08%3A15%3A03&fee_usd=15.00&marketing_consent=1&order_id=384786-7814479&p_signature=KVwPnai%2F5WNLu3HqOTlwrLz%2B1tmoYPxtxTHTD4fUj6doFpR6E%2F1dPoneczSj%2FM57lixZInqgRv%2F0PLypxO7qi0NY3D008iolPSYCYWg0Sl6t6ZzpQSFQrjbvFVhn9S1vOVCreoaFSk96m4WCeE0lO5pg0zzf5cDt4cKXqa6y7QeRmrixxDfuk9ZapfPNzS36zhd31WhGU5I%2FeBa8p%2FPVom4nvEB%2Fj%2Bp7FjubwUyqTT7O2DR6m90WMDgkpMRTfuPEnPjxIpXb9b6N2E08%2FYkBw%2B0xSJXlFBJldT5ZAJs0385VDOO7McH3%2FL3a3sWLWCc2GJZYZ2JU%2B3pdC8QR%2Flr6aQ%3D%3D&passthrough=%7B%22account_id%22%3A42%7D&status=open"
+const paymentDisputeClosedPostBody = "alert_id=1903544&alert_name=payment_dispute_closed&amount=49.00&balance_amount=49.00&balance_currency=USD&balance_fee=15.00&checkout_id=1042907-chre0e6f7ff6b4d-34f2e6e1b0&currency=USD&email=qa%40screenshotone.com&event_time=2022-07-20+16%3A40%3A11&fee_usd=15.00&marketing_consent=1&order_id=384786-7814479&p_signature=YARtaxZa3PPZhRXkFiVahskcs3tOMcme5VjJ6owC7W0r5lD2MbU6jjSwhYoQP%2BbR6XBQZcLGZuMrlloWBV62pFe4DjVXv%2BkA6YiY7bec7UGhQnb%2FxhBHtL69pwJebjs5%2FHzrdN237Ipyn%2BNStPSVM1GZuwq0uMgcnZzYT%2Fh2Catzc1ycHPHty89p0p%2FehQADbnlsQV2iL7yp%2F3dMcYbyrmJ4vh7ltf0se2AAeXQ7jGgcnn402IYZcPgkL8vlclL96ZzdezcNJzPvdNcLKSAjV4CazO0X7VmCQOJurTJpyyLwl3LNA6aaMZ%2FD0uulFriRpltnFfLkYmTwyOfPrl10WQ%3D%3D&passthrough=%7B%22account_id%22%3A42%7D&status=closed"
+const highRiskTransactionCreatedPostBody = "alert_id=1903601&alert_name=high_risk_transaction_created&case_id=8821&checkout_id=1043311-chre5b9d0a0c1e2-7f3b2c1d0e&created_at=2022-07-21+10%3A02%3A45&customer_email_address=risky%40example.com&customer_user_id=176099&event_time=2022-07-21+10%3A02%3A46&marketing_consent=0&p_signature=NOeciglHEYTQKGIx%2F0Hj7cnO4jIwG1ste1Kk6%2BhZgxVMJxVFxxJK2BTIj0o00JgYZ10pcduK9RTsM92SVDErK8iBbAR61BVtAJL6rKYyPr74I0mHfb78Ru8c93zLskXFBShieB3AaNjLVz8m418ljVkC5FBuoMmAbn6LHoaG%2Fy835E3i1tZlgly%2BGGRDf7RU%2FpwRsfWPnxG2VwiluUq6%2F6Fgb4oSXdgtOzDy%2FzJ%2B6APVtIyLj%2BwIolLCrBcuL9lfjJOVVMu2txmdup%2B%2BRIq9zU0X1y4NptlW%2B%2F8vCdA77CKGqy%2BpUkWn9xKC4WA0Iv1PrOXPiWQkaFQPhvzSSC%2BvXQ%3D%3D&passthrough=%7B%22account_id%22%3A77%7D&product_id=514032&risk_score=78.5&status=pending"
+const highRiskTransactionUpdatedPostBody = "alert_id=1903742&alert_name=high_risk_transaction_updated&case_id=8821&checkout_id=1043311-chre5b9d0a0c1e2-7f3b2c1d0e&created_at=2022-07-21+10%3A02%3A45&customer_email_address=risky%40example.com&customer_user_id=176099&event_time=2022-07-21+14%3A31%3A09&marketing_consent=0&order_id=384901-7815002&p_signature=IBpRe%2B8v7bMhhQTM5TF%2F%2FyiNbW1WUPOySafMNfjwhqc2vODXkAt%2F7RJB6rOHpWP3PLqaaMnzw5wMYxACfo%2FAomWaGdWfql%2FAJ4Hz%2BSw1%2BJtgzqBKi85yQikCk1ICePZxt%2FNmWNF5CFavwbuMViNRDBxL6QLQ3UaKbRP8%2BhDQiz2zrw9Lv%2F124u14%2F1kuYu%2B9SeQFXuQs2aGTSvdO%2BeC7RZJh0KAte9sTmtArui32EjqvUKswiyaaM4DDWNiBa%2BeLfGol8Wt9ctuigDpoBgeDRbbPb2ZV%2Bsr09amaAH76Ql3PxQSsRL10w%2BlV5e1mg5VXq9FVh826Y5uMABpp235Z0A%3D%3D&passthrough=%7B%22account_id%22%3A77%7D&product_id=514032&risk_score=78.5&status=rejected"
+const lockerProcessedPostBody = "alert_id=1904010&alert_name=locker_processed&checkout_id=1042907-chre0e6f7ff6b4d-34f2e6e1b0&checkout_recovery=0&coupon=&download=https%3A%2F%2Fexample.com%2Fdownload&email=qa%40screenshotone.com&event_time=2022-06-14+13%3A38%3A22&instructions=Enter+the+license+code+in+the+app.&licence=D6A1B3E6-1B5C3A1F&marketing_consent=1&order_id=384786-7814479&p_signature=Xtus7IE79N59XiZuKAwfYHY9WH2xi6ELySbDHuZcXEpzS%2B5O0u3DzbXjxlsE%2FbPFq%2BduxphI%2FP%2FrITNZR4RSssK%2BrRGkekAnRH4s27K5RjSaUVF1EBil67TkPNuX%2BLkQH0okAX6WbuIwkitx9TXnOSwcYOyB1YjUwe2GW6Ku3nRZ%2Bvpaonv4uOvsR2iLy1GjP%2FK9ETZWlzidqhzJW8v3WCXW75CLu64dK0TfcIehhzlafHTvg8urzVwlwtQt5jTQOXpGYbv6zUSeoptTrXGns7zLDTI9ZA2tMDBM0%2BO0e0lDXKXxE39UP3h2jf5pjPcBCCV9XmmJEIF1kEvvL%2BQzcQ%3D%3D&product_id=514032&quantity=1&source=Checkout"
+const transferCreatedPostBody = "alert_id=1904120&alert_name=transfer_created&amount=1520.35&currency=USD&event_time=2022-07-01+00%3A05%3A12&p_signature=ZrNAvQwIErtSK5FStgzdyhpva3BhSj2NmWmoUC%2BYbty1W%2FhqIpZtkWYctv5OLjh45fAA0pvPzDj50iy%2Bp1n5pzltWFax1Cts%2BQFVn1fb3qcvCv6PKfiMCRxnEe7tVpPcOcDAZTHiVC8EGSbfaCktP7xnv6vJwIExFdYDyH6Clsq2fOhyhVVrY88hU273xV3Lfod8j4joJIaaaYmNp9d%2F49vqrmstvki2bBsR9vPjZM%2B4PnJtgVJwkMZx%2BmV3shr0aVZbKBV61svMjilu5z8ZRNh3SK5tD2wD0cxNfxdN1mrFL6foeINRuuVsQ7kGC67GkKM22yOKE5BtwkLOwZVuRg%3D%3D&payout_id=3317&status=unpaid"
+const transferPaidPostBody = "alert_id=1904388&alert_name=transfer_paid&amount=1520.35&currency=USD&event_time=2022-07-05+11%3A20%3A40&p_signature=JVLgaBPSBa4BWvCghUWDrgRjuKqiKiVLbCMKQf1v%2BZPAGxyFFfWFJ71szEAgj8Pq%2B%2FG8wQZakCDz%2BEVy1dNLBzAw%2Fw6BOvjmkL782zREnO%2FhJev%2FeeTmcjrOm3NUAchtBiNFhmQEFLS6QvOZNzB%2FAKPxedPdoA%2BDveKJD5nAvj9tVNh0jbEc9aCSzTViNZqFNEzImVjUUNWokI9bEH17qpFDT6WtwysBuMbjfNRBRpaEHwR8%2FmSNn1FUZmUr%2FU69o6HVNBlRngTK9uxPZ2WP7C0bYMaoq1JU5fv2VkLTLyvaoVxlys9wA9%2BJLeADZ4rtLfsPOAJEufBmL3l4lWS5yg%3D%3D&payout_id=3317&status=paid"
+const newAudienceMemberPostBody = "alert_id=1904502&alert_name=new_audience_member&created_at=2022-06-14+13%3A38%3A20&email=qa%40screenshotone.com&event_time=2022-06-14+13%3A38%3A21&marketing_consent=1&p_signature=bP4Ed71yrDTnnYVN%2Bj9z7lZgUS0Fx3oB3888FP84R3P7oBXq3caW53Qn206rABVf0Agdxe8IEsGBxhCW7Jro3ke8SdZbNH7MzLaqwI4%2BKcVH5x%2B5nyYfZs0zQaBr5l%2FnFwtFhHJqGNgSyvKuk8k0ImreLqSjlbXpL5kM0w2UcSUXQjsIiYPlHYy%2FBHEjnyKbEPVYns7AHf0%2F7%2BkUoGASd23Y%2Fc3%2FNJKtVjsVS9Z1H%2B7deBOuqQO1p42v9HP5k59DSC0amTff%2FNXC9tiK3kpBxtPrWdkj8QpAwJnyJBx7yHuDZh60%2BlITducIr3N1%2BEFBe2j7ugimzii6p3bRfSjxcQ%3D%3D&products=514032%2C514033&source=Checkout&subscribed=1&user_id=176032"
+const updateAudienceMemberPostBody = "alert_id=1904631&alert_name=update_audience_member&event_time=2022-08-02+10%3A11%3A12&new_customer_email=sam%40screenshotone.com&new_marketing_consent=0&old_customer_email=qa%40screenshotone.com&old_marketing_consent=1&p_signature=ZtGG9f%2BVIx49oIVZ9SQKPM4ZR17RmJLwrQElefEbB58BMJKbmzgKD8FIZfTDhgYpBBRUi%2BBPxV%2BIu0tUEQtPukwp4WuUol2%2FNaMLQFTpCab0NtK7tvvuiofD%2BHNLoZO86jtDtuSrmCm4tz%2FsG8hwKcJ0d6OqAavpyOPFJEuJO9putfADTIwlBePNpDCUc14GZ3YK4VWO1asSZ9QUFFCpkKt7Q0p4rB31ClsPpVoWhMY27c6LXUedVxtW1P8js7ib7tIO44qcWP1n61SFapioQsOtVvYqHM90EzDhoEy0GisALkktaGwR5ZYWCw0hQ%2FtkfpUu6UT6xuWVMSqRTV77mw%3D%3D&products=514032&source=Checkout&updated_at=2022-08-02+10%3A11%3A10&user_id=176032"
+const invoicePaidPostBody = "alert_id=1905010&alert_name=invoice_paid&amount=1200.00&balance_currency=USD&balance_earnings=1140.00&balance_fee=60.00&balance_gross=1200.00&balance_tax=0&contract_end_date=2023-08-31&contract_id=742&contract_start_date=2022-09-01&country=DE&currency=USD&customer_address=Friedrichstra%C3%9Fe+123&customer_city=Berlin&customer_company_number=HRB+123456&customer_id=9021&customer_name=Acme+GmbH&customer_state=Berlin&customer_vat_number=DE123456789&customer_zipcode=10117&date_created=2022-09-01+09%3A00%3A00&date_reconciled=2022-09-20+12%3A30%3A00&earnings=1140.00&email=billing%40acme.example&event_time=2022-09-20+12%3A30%3A01&fee=60.00&invoiced_at=2022-09-01+09%3A05%3A00&p_signature=FFxGu3kqe1YSSQfcCpfYSSbOFjsGOtSvoHE5JFGxMs%2FITIZTjsLv1mU50vLD7Zl0SMrB03Uxs9JbYBiZPjZ8JH5wtmiyUcGIuDPb6sapxvnumnW18F3faXYp2Md7PcJL4aYQQsJbwR5oKr5iEqNyjRjBGrVt4fr5%2BfqEGk6Dtbw3JH6Ry0Iie1MZlc9GQwMxkXqC8VwE%2F3khl6DtCiCHB3tY0iHXG2c6eKjHPyq6TyspFvgbk5%2F9EJHUsGp6rv1yGUrd07jVlzNbPws0Vrcb4AlXvylcye70iLmlZR%2Fi487iFRNS5Yao8gzTNCnmeOttOqlZY1zjOQK0D6S7FompMg%3D%3D&passthrough=%7B%22account_id%22%3A42%7D&payment_id=61003&payment_method=wire-transfer&payment_tax=0&product_additional_information=Annual+enterprise+plan&product_id=514040&product_name=Enterprise&purchase_order_number=PO-2022-0042&sale_gross=1200.00&status=paid&term_days=30"
+const invoiceSentPostBody = "alert_id=1905001&alert_name=invoice_sent&amount=1200.00&balance_currency=USD&contract_end_date=2023-08-31&contract_id=742&contract_start_date=2022-09-01&country=DE&currency=USD&customer_address=Friedrichstra%C3%9Fe+123&customer_city=Berlin&customer_company_number=HRB+123456&customer_id=9021&customer_name=Acme+GmbH&customer_state=Berlin&customer_vat_number=DE123456789&customer_zipcode=10117&date_created=2022-09-01+09%3A00%3A00&email=billing%40acme.example&event_time=2022-09-01+09%3A05%3A01&invoiced_at=2022-09-01+09%3A05%3A00&p_signature=iodfABSc0aWpgVe59tzWq90NKXgvx6DoHt1NM%2Fsq6aIttN6tLOrAqRuvip1cAlDA3yZ%2FU7%2BYQiZ3klzoL8cK0bv%2FQIBeQVqLXd18%2Bv7hij%2BuzkYgZggjmezDoSTseluS4fOJZYpvlLRmdT9d5PgQKl%2BwXlr7PQ8fSz6XhFfMzY4Ayz3AejEMqp9goSMHGuceVfa1F5FG%2B31FOOFAnSCaRh3LgbwALIdxik0hNr8T1gS9AzIssZ33pQj%2FqxKmhDo91qEzo5b0Hl64sRtBAZ2nQFv2YBqEWbCIf4rbcrmVn2nGFFoGrQ4XFuPnaBu4urbyWriacha4iOjoo2ScW30wyA%3D%3D&passthrough=%7B%22account_id%22%3A42%7D&payment_id=61003&payment_tax=0&product_additional_information=Annual+enterprise+plan&product_id=514040&product_name=Enterprise&purchase_order_number=PO-2022-0042&sale_gross=1200.00&status=unpaid&term_days=30"
+const invoiceOverduePostBody = "alert_id=1905120&alert_name=invoice_overdue&amount=1200.00&balance_currency=USD&contract_end_date=&contract_id=0&contract_start_date=&country=DE&currency=USD&customer_address=Friedrichstra%C3%9Fe+123&customer_city=Berlin&customer_company_number=HRB+123456&customer_id=9021&customer_name=Acme+GmbH&customer_state=Berlin&customer_vat_number=DE123456789&customer_zipcode=10117&date_created=2022-10-01+09%3A00%3A00&email=billing%40acme.example&event_time=2022-11-01+00%3A00%3A05&invoiced_at=2022-10-01+09%3A05%3A00&p_signature=g7GfiiBsPL7SOtI%2BiCBltU6617UW3Jg15zHvshPQc302bwBAbqKLNTTuNQAtTJFhugjDHHLKG9qvwEd6wllPrQ7bVDkHZ0J2JtN5UaaSVK8nZAbuX63t4HBgz4zz6JRX677O%2B48MG2zWMbsE7r6WsmbSFQHeRszhI0rVeUKlv7OmF4typAbADQpQOiFC2Md77TK%2ByUnVsc9V77lniCPb8fzrQjQuQ6rtyNwWuTld4JXnUaQPjYlo%2B31cfOgshj3Y8ANwORQ%2F%2B%2BDIbGDKQkXo8AMAtxM1iSZHYgVFCJm7NliEJ9h3vSLurPsuObb24Qm%2BmYYKoll0aZqTnC9wJ6YNjA%3D%3D&passthrough=%7B%22account_id%22%3A42%7D&payment_id=61877&payment_tax=0&product_additional_information=&product_id=514040&product_name=Enterprise&purchase_order_number=&sale_gross=1200.00&status=overdue&term_days=30"
+const unknownAlertPostBody = "alert_id=1906001&alert_name=subscription_scheduled_change&event_time=2022-12-01+10%3A00%3A00&p_signature=kBRKh6ooS2PXbyy0S%2BoXhnU3Hfm9TY%2F0XooAt4R2S5SVmZ2lBfCcQnsN%2F8Y8iYNmvu1A9jsGvFxCFKjmXxXz7KB3gUc681AtrVKIQJgB7VAMM0BX5oL4Z99OEOUnXLUnFtVluEZzUy2jpwTJqRVInXweEi%2FRaNQZGuCBO0EU%2B995HjmmKp%2BYK7RpKQgxtE6QsQT%2FDOFfAOdDpkpNO8j%2FcmMdAdA%2Bx5Wb2nV74ZV9jmojxkpeZsSsU3yLKNrxI127v3WbG39tlEkmuOnAQVV99XRlormnJgzgYeCMQMtJREvxZErNphe%2Fvs%2FK%2FecVr4n9VtDiIspIqK47QsSq53%2FCDw%3D%3D&subscription_id=250148&user_id=176032"